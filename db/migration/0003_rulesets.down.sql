@@ -0,0 +1,16 @@
+-- Quitar columnas de confianza de prode_carreras
+ALTER TABLE prode_carreras
+    DROP FOREIGN KEY fk_prode_carreras_ruleset,
+    DROP INDEX idx_ruleset_id,
+    DROP COLUMN ruleset_id,
+    DROP COLUMN confidence_p1,
+    DROP COLUMN confidence_p2,
+    DROP COLUMN confidence_p3,
+    DROP COLUMN confidence_p4,
+    DROP COLUMN confidence_p5,
+    DROP COLUMN confidence_vsc,
+    DROP COLUMN confidence_sc,
+    DROP COLUMN confidence_dnf;
+
+-- Eliminar tabla rulesets
+DROP TABLE IF EXISTS rulesets;
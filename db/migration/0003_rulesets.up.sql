@@ -0,0 +1,28 @@
+CREATE TABLE rulesets (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    mode VARCHAR(20) NOT NULL DEFAULT 'classic',
+    confidence_budget INT DEFAULT 10,
+    max_per_pick INT DEFAULT 0,
+    active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_ruleset_name (name)
+);
+
+INSERT INTO rulesets (name, mode, confidence_budget, max_per_pick) VALUES
+('classic', 'classic', 0, 0),
+('confidence-10', 'confidence', 10, 5);
+
+ALTER TABLE prode_carreras
+    ADD COLUMN ruleset_id INT NULL,
+    ADD COLUMN confidence_p1 INT DEFAULT 0,
+    ADD COLUMN confidence_p2 INT DEFAULT 0,
+    ADD COLUMN confidence_p3 INT DEFAULT 0,
+    ADD COLUMN confidence_p4 INT DEFAULT 0,
+    ADD COLUMN confidence_p5 INT DEFAULT 0,
+    ADD COLUMN confidence_vsc INT DEFAULT 0,
+    ADD COLUMN confidence_sc INT DEFAULT 0,
+    ADD COLUMN confidence_dnf INT DEFAULT 0,
+    ADD INDEX idx_ruleset_id (ruleset_id),
+    ADD CONSTRAINT fk_prode_carreras_ruleset FOREIGN KEY (ruleset_id) REFERENCES rulesets(id) ON DELETE SET NULL ON UPDATE CASCADE;
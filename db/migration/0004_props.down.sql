@@ -0,0 +1,8 @@
+-- Eliminar tabla session_prop_results
+DROP TABLE IF EXISTS session_prop_results;
+
+-- Eliminar tabla prode_carrera_props
+DROP TABLE IF EXISTS prode_carrera_props;
+
+-- Eliminar tabla props
+DROP TABLE IF EXISTS props;
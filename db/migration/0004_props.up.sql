@@ -0,0 +1,46 @@
+CREATE TABLE props (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(255),
+    answer_type VARCHAR(20) NOT NULL,
+    points INT DEFAULT 0,
+    tolerance DOUBLE DEFAULT 0,
+    active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_prop_code (code)
+);
+
+CREATE TABLE prode_carrera_props (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    prode_carrera_id INT NOT NULL,
+    prop_id INT NOT NULL,
+    answer VARCHAR(100) NOT NULL,
+    score INT DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_prode_carrera_id (prode_carrera_id),
+    INDEX idx_prop_id (prop_id),
+    UNIQUE INDEX idx_prode_carrera_prop (prode_carrera_id, prop_id),
+    CONSTRAINT fk_prode_carrera_props_prode FOREIGN KEY (prode_carrera_id) REFERENCES prode_carreras(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_prode_carrera_props_prop FOREIGN KEY (prop_id) REFERENCES props(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE session_prop_results (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    session_id INT NOT NULL,
+    prop_id INT NOT NULL,
+    answer VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_session_prop (session_id, prop_id),
+    CONSTRAINT fk_session_prop_results_session FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_session_prop_results_prop FOREIGN KEY (prop_id) REFERENCES props(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+INSERT INTO props (code, name, description, answer_type, points, tolerance) VALUES
+('pit_stops', 'Pit stops totales', 'Cantidad total de pit stops en la carrera', 'integer', 5, 2),
+('winning_margin', 'Margen de victoria', 'Segundos entre el ganador y el segundo', 'number', 5, 1),
+('first_retirement', 'Primer abandono', 'Piloto que abandona primero (0 = nadie abandona)', 'driver', 8, 0),
+('red_flag', 'Bandera roja', 'Si hay al menos una bandera roja en la carrera', 'boolean', 3, 0);
@@ -0,0 +1,8 @@
+-- Eliminar tabla survivor_picks
+DROP TABLE IF EXISTS survivor_picks;
+
+-- Eliminar tabla survivor_entries
+DROP TABLE IF EXISTS survivor_entries;
+
+-- Eliminar tabla survivor_leagues
+DROP TABLE IF EXISTS survivor_leagues;
@@ -0,0 +1,44 @@
+CREATE TABLE survivor_leagues (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    group_id INT NOT NULL,
+    year INT NOT NULL,
+    top_n INT DEFAULT 10,
+    finished BOOLEAN DEFAULT FALSE,
+    winner_user_id INT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_survivor_group_year (group_id, year),
+    CONSTRAINT fk_survivor_leagues_group FOREIGN KEY (group_id) REFERENCES `groups`(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_survivor_leagues_winner FOREIGN KEY (winner_user_id) REFERENCES users(id) ON DELETE SET NULL ON UPDATE CASCADE
+);
+
+CREATE TABLE survivor_entries (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    league_id INT NOT NULL,
+    user_id INT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'alive',
+    eliminated_session_id INT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_survivor_league_user (league_id, user_id),
+    INDEX idx_user_id (user_id),
+    CONSTRAINT fk_survivor_entries_league FOREIGN KEY (league_id) REFERENCES survivor_leagues(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_survivor_entries_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_survivor_entries_session FOREIGN KEY (eliminated_session_id) REFERENCES sessions(id) ON DELETE SET NULL ON UPDATE CASCADE
+);
+
+CREATE TABLE survivor_picks (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    entry_id INT NOT NULL,
+    session_id INT NOT NULL,
+    driver_id INT NOT NULL,
+    result VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_survivor_entry_session (entry_id, session_id),
+    UNIQUE INDEX idx_survivor_entry_driver (entry_id, driver_id),
+    INDEX idx_session_id (session_id),
+    CONSTRAINT fk_survivor_picks_entry FOREIGN KEY (entry_id) REFERENCES survivor_entries(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_survivor_picks_session FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_survivor_picks_driver FOREIGN KEY (driver_id) REFERENCES drivers(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,17 @@
+-- Eliminar tabla fantasy_rounds
+DROP TABLE IF EXISTS fantasy_rounds;
+
+-- Eliminar tabla fantasy_lineup_drivers
+DROP TABLE IF EXISTS fantasy_lineup_drivers;
+
+-- Eliminar tabla fantasy_lineups
+DROP TABLE IF EXISTS fantasy_lineups;
+
+-- Eliminar tabla fantasy_teams
+DROP TABLE IF EXISTS fantasy_teams;
+
+-- Eliminar tabla fantasy_constructor_prices
+DROP TABLE IF EXISTS fantasy_constructor_prices;
+
+-- Eliminar tabla fantasy_driver_prices
+DROP TABLE IF EXISTS fantasy_driver_prices;
@@ -0,0 +1,72 @@
+CREATE TABLE fantasy_driver_prices (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    driver_id INT NOT NULL,
+    year INT NOT NULL,
+    price DOUBLE NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_fantasy_driver_year (driver_id, year),
+    CONSTRAINT fk_fantasy_driver_prices_driver FOREIGN KEY (driver_id) REFERENCES drivers(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE fantasy_constructor_prices (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    team_name VARCHAR(100) NOT NULL,
+    year INT NOT NULL,
+    price DOUBLE NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_fantasy_constructor_year (team_name, year)
+);
+
+CREATE TABLE fantasy_teams (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    year INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    total_points INT DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_fantasy_user_year (user_id, year),
+    INDEX idx_year_points (year, total_points),
+    CONSTRAINT fk_fantasy_teams_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE fantasy_lineups (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    team_id INT NOT NULL,
+    session_id INT NOT NULL,
+    constructor_name VARCHAR(100) NULL,
+    constructor_cost DOUBLE DEFAULT 0,
+    cost DOUBLE DEFAULT 0,
+    transfers INT DEFAULT 0,
+    points INT DEFAULT 0,
+    scored BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_fantasy_team_session (team_id, session_id),
+    INDEX idx_session_id (session_id),
+    CONSTRAINT fk_fantasy_lineups_team FOREIGN KEY (team_id) REFERENCES fantasy_teams(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_fantasy_lineups_session FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE fantasy_lineup_drivers (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    lineup_id INT NOT NULL,
+    driver_id INT NOT NULL,
+    price DOUBLE NOT NULL,
+    points INT DEFAULT 0,
+    UNIQUE INDEX idx_fantasy_lineup_driver (lineup_id, driver_id),
+    CONSTRAINT fk_fantasy_lineup_drivers_lineup FOREIGN KEY (lineup_id) REFERENCES fantasy_lineups(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_fantasy_lineup_drivers_driver FOREIGN KEY (driver_id) REFERENCES drivers(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE fantasy_rounds (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    session_id INT NOT NULL,
+    year INT NOT NULL,
+    scored_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_fantasy_round_session (session_id),
+    INDEX idx_year (year),
+    CONSTRAINT fk_fantasy_rounds_session FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,2 @@
+-- Eliminar tabla user_season_scores
+DROP TABLE IF EXISTS user_season_scores;
@@ -0,0 +1,31 @@
+CREATE TABLE user_season_scores (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    user_id INT NOT NULL,
+    year INT NOT NULL,
+    score INT DEFAULT 0,
+    position INT NULL,
+    archived BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_user_season (user_id, year),
+    INDEX idx_season_score (year, score),
+    CONSTRAINT fk_user_season_scores_user FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- Cada temporada arranca con la suma de los puntajes de los prodes de sus sesiones
+INSERT INTO user_season_scores (user_id, year, score)
+SELECT p.user_id, p.year, SUM(p.score)
+FROM (
+    SELECT pc.user_id, COALESCE(s.year, YEAR(s.date_start)) AS year, pc.score
+    FROM prode_carreras pc
+    JOIN sessions s ON s.id = pc.session_id
+    WHERE pc.deleted_at IS NULL
+    UNION ALL
+    SELECT ps.user_id, COALESCE(s.year, YEAR(s.date_start)) AS year, ps.score
+    FROM prode_sessions ps
+    JOIN sessions s ON s.id = ps.session_id
+    WHERE ps.deleted_at IS NULL
+) p
+JOIN users u ON u.id = p.user_id
+GROUP BY p.user_id, p.year
+HAVING SUM(p.score) <> 0;
@@ -0,0 +1,2 @@
+-- Eliminar tabla session_ingestions
+DROP TABLE IF EXISTS session_ingestions;
@@ -0,0 +1,5 @@
+-- Eliminar tabla result_amendments
+DROP TABLE IF EXISTS result_amendments;
+
+-- Eliminar tabla session_result_statuses
+DROP TABLE IF EXISTS session_result_statuses;
@@ -0,0 +1,33 @@
+CREATE TABLE session_result_statuses (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    session_id INT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'provisional',
+    official_at TIMESTAMP NULL,
+    amended_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_session_result_statuses_session (session_id),
+    CONSTRAINT fk_session_result_statuses_session FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE result_amendments (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    session_id INT NOT NULL,
+    result_id INT NOT NULL,
+    driver_id INT NOT NULL,
+    previous_position INT NULL,
+    new_position INT NULL,
+    previous_status VARCHAR(20),
+    new_status VARCHAR(20),
+    previous_fastest_lap DOUBLE DEFAULT 0,
+    new_fastest_lap DOUBLE DEFAULT 0,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_result_amendments_session (session_id),
+    INDEX idx_result_amendments_result (result_id),
+    CONSTRAINT fk_result_amendments_result FOREIGN KEY (result_id) REFERENCES results(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- Los resultados cargados hasta ahora ya se usaron para puntuar, se consideran oficiales
+INSERT INTO session_result_statuses (session_id, status, official_at)
+SELECT DISTINCT session_id, 'official', CURRENT_TIMESTAMP FROM results WHERE session_id IS NOT NULL;
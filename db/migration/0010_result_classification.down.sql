@@ -0,0 +1,8 @@
+-- Eliminar columnas de clasificación detallada de results
+ALTER TABLE results
+    DROP COLUMN grid_position,
+    DROP COLUMN laps_completed,
+    DROP COLUMN total_time,
+    DROP COLUMN gap_to_leader,
+    DROP COLUMN interval_to_ahead,
+    DROP COLUMN laps_behind;
@@ -0,0 +1,7 @@
+ALTER TABLE results
+    ADD COLUMN grid_position INT NULL,
+    ADD COLUMN laps_completed INT DEFAULT 0,
+    ADD COLUMN total_time DOUBLE NULL,
+    ADD COLUMN gap_to_leader DOUBLE NULL,
+    ADD COLUMN interval_to_ahead DOUBLE NULL,
+    ADD COLUMN laps_behind INT DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Eliminar tabla laps
+DROP TABLE IF EXISTS laps;
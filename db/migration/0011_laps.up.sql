@@ -0,0 +1,17 @@
+CREATE TABLE laps (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    session_id INT NOT NULL,
+    driver_id INT NOT NULL,
+    lap_number INT NOT NULL,
+    lap_duration DOUBLE NULL,
+    sector1 DOUBLE NULL,
+    sector2 DOUBLE NULL,
+    sector3 DOUBLE NULL,
+    is_pit_in_lap BOOLEAN DEFAULT FALSE,
+    is_pit_out_lap BOOLEAN DEFAULT FALSE,
+    date_start TIMESTAMP(3) NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_laps_session_driver_lap (session_id, driver_id, lap_number),
+    CONSTRAINT fk_laps_session FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_laps_driver FOREIGN KEY (driver_id) REFERENCES drivers(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,2 @@
+-- Eliminar tabla pit_stops
+DROP TABLE IF EXISTS pit_stops;
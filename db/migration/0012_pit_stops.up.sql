@@ -0,0 +1,12 @@
+CREATE TABLE pit_stops (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    session_id INT NOT NULL,
+    driver_id INT NOT NULL,
+    lap_number INT NOT NULL,
+    pit_duration DOUBLE NULL,
+    date TIMESTAMP(3) NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_pit_stops_session_driver_lap (session_id, driver_id, lap_number),
+    CONSTRAINT fk_pit_stops_session FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT fk_pit_stops_driver FOREIGN KEY (driver_id) REFERENCES drivers(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
@@ -0,0 +1,5 @@
+-- Eliminar columna points de results
+ALTER TABLE results DROP COLUMN points;
+
+-- Eliminar tabla points_systems
+DROP TABLE IF EXISTS points_systems;
@@ -0,0 +1,22 @@
+CREATE TABLE points_systems (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    year INT NOT NULL,
+    session_kind VARCHAR(20) NOT NULL,
+    points VARCHAR(100) NOT NULL,
+    fastest_lap_points INT DEFAULT 0,
+    fastest_lap_max_position INT DEFAULT 10,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_points_systems_year_kind (year, session_kind)
+);
+
+-- Cada fila rige desde su temporada hasta la siguiente del mismo tipo
+INSERT INTO points_systems (year, session_kind, points, fastest_lap_points, fastest_lap_max_position) VALUES
+(2010, 'race', '25,18,15,12,10,8,6,4,2,1', 0, 10),
+(2019, 'race', '25,18,15,12,10,8,6,4,2,1', 1, 10),
+(2025, 'race', '25,18,15,12,10,8,6,4,2,1', 0, 10),
+(2021, 'sprint', '3,2,1', 0, 0),
+(2022, 'sprint', '8,7,6,5,4,3,2,1', 0, 0);
+
+ALTER TABLE results
+    ADD COLUMN points DOUBLE DEFAULT 0;
@@ -0,0 +1,6 @@
+-- Eliminar columnas de tandas de clasificación de results
+ALTER TABLE results
+    DROP COLUMN q1_time,
+    DROP COLUMN q2_time,
+    DROP COLUMN q3_time,
+    DROP COLUMN knockout_segment;
@@ -0,0 +1,5 @@
+ALTER TABLE results
+    ADD COLUMN q1_time DOUBLE NULL,
+    ADD COLUMN q2_time DOUBLE NULL,
+    ADD COLUMN q3_time DOUBLE NULL,
+    ADD COLUMN knockout_segment VARCHAR(2) NULL;
@@ -0,0 +1,3 @@
+-- Eliminar prop de lluvia y tabla weather_samples
+DELETE FROM props WHERE code = 'rain';
+DROP TABLE IF EXISTS weather_samples;
@@ -0,0 +1,18 @@
+CREATE TABLE weather_samples (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    session_id INT NOT NULL,
+    date TIMESTAMP(3) NOT NULL,
+    air_temperature DOUBLE DEFAULT 0,
+    track_temperature DOUBLE DEFAULT 0,
+    humidity DOUBLE DEFAULT 0,
+    pressure DOUBLE DEFAULT 0,
+    rainfall BOOLEAN DEFAULT FALSE,
+    wind_direction INT DEFAULT 0,
+    wind_speed DOUBLE DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_weather_samples_session_date (session_id, date),
+    CONSTRAINT fk_weather_samples_session FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+INSERT INTO props (code, name, description, answer_type, points, tolerance) VALUES
+('rain', 'Lluvia', 'Si llueve en algún momento de la carrera', 'boolean', 3, 0);
@@ -0,0 +1,5 @@
+-- Eliminar índices de estadísticas de resultados
+DROP INDEX idx_drivers_team_name ON drivers;
+DROP INDEX idx_results_session_stats ON results;
+DROP INDEX idx_sessions_year ON sessions;
+DROP INDEX idx_sessions_name_date_start ON sessions;
@@ -0,0 +1,5 @@
+-- Índices para las estadísticas agregadas de resultados y el campeonato
+CREATE INDEX idx_sessions_name_date_start ON sessions (session_name, date_start);
+CREATE INDEX idx_sessions_year ON sessions (year);
+CREATE INDEX idx_results_session_stats ON results (session_id, driver_id, status(20), position);
+CREATE INDEX idx_drivers_team_name ON drivers (team_name);
@@ -0,0 +1,4 @@
+-- Eliminar tabla meetings y la referencia desde sessions
+ALTER TABLE sessions DROP FOREIGN KEY fk_sessions_meeting;
+DROP INDEX fk_sessions_meeting ON sessions;
+DROP TABLE IF EXISTS meetings;
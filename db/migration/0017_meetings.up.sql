@@ -0,0 +1,43 @@
+CREATE TABLE meetings (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    circuit_key INT,
+    circuit_short_name VARCHAR(255),
+    country_code VARCHAR(255),
+    country_key INT,
+    country_name VARCHAR(255),
+    location VARCHAR(255),
+    date_start TIMESTAMP NULL,
+    date_end TIMESTAMP NULL,
+    year INT,
+    format VARCHAR(20) NOT NULL DEFAULT 'standard',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    INDEX idx_meetings_year_date_start (year, date_start),
+    INDEX idx_meetings_deleted_at (deleted_at)
+);
+
+-- Un meeting por cada weekend_id ya cargado, con los datos del circuito de sus sesiones.
+-- El nombre es provisorio y se corrige con PUT /meetings/:id
+INSERT INTO meetings (id, name, circuit_key, circuit_short_name, country_code, country_key, country_name, location, date_start, date_end, year, format)
+SELECT weekend_id,
+       CONCAT(MAX(country_name), ' Grand Prix'),
+       MAX(circuit_key),
+       MAX(circuit_short_name),
+       MAX(country_code),
+       MAX(country_key),
+       MAX(country_name),
+       MAX(location),
+       MIN(date_start),
+       MAX(date_end),
+       MAX(year),
+       IF(SUM(session_name LIKE 'Sprint%') > 0, 'sprint', 'standard')
+FROM sessions
+WHERE weekend_id IS NOT NULL AND weekend_id > 0
+GROUP BY weekend_id;
+
+UPDATE sessions SET weekend_id = NULL WHERE weekend_id = 0;
+
+ALTER TABLE sessions
+    ADD CONSTRAINT fk_sessions_meeting FOREIGN KEY (weekend_id) REFERENCES meetings(id) ON DELETE RESTRICT ON UPDATE CASCADE;
@@ -0,0 +1,7 @@
+-- Eliminar rangos de props y carga manual de valores reales
+ALTER TABLE session_prop_results DROP COLUMN manual;
+
+ALTER TABLE props ADD COLUMN tolerance DOUBLE DEFAULT 0 AFTER points;
+UPDATE props SET tolerance = 2 WHERE code = 'pit_stops';
+UPDATE props SET tolerance = 1 WHERE code = 'winning_margin';
+ALTER TABLE props DROP COLUMN buckets;
@@ -0,0 +1,9 @@
+-- Las respuestas numéricas de los props se puntúan por rango en lugar de por tolerancia
+ALTER TABLE props ADD COLUMN buckets VARCHAR(255) NULL AFTER points;
+ALTER TABLE props DROP COLUMN tolerance;
+
+UPDATE props SET buckets = '0,20,25,30,35' WHERE code = 'pit_stops';
+UPDATE props SET buckets = '0,1,3,5,10,20' WHERE code = 'winning_margin';
+
+-- Valores reales cargados a mano por un admin
+ALTER TABLE session_prop_results ADD COLUMN manual BOOLEAN DEFAULT FALSE AFTER answer;
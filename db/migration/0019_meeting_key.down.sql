@@ -0,0 +1,4 @@
+-- Eliminar la columna meeting_key de meetings
+ALTER TABLE meetings
+    DROP INDEX idx_meetings_meeting_key,
+    DROP COLUMN meeting_key;
@@ -0,0 +1,8 @@
+ALTER TABLE meetings
+    ADD COLUMN meeting_key INT NULL AFTER id,
+    ADD UNIQUE INDEX idx_meetings_meeting_key (meeting_key);
+
+-- Los fines de semana cargados desde OpenF1 (sesiones con session_key) usaban el meeting_key como weekend_id
+UPDATE meetings m
+SET m.meeting_key = m.id
+WHERE EXISTS (SELECT 1 FROM sessions s WHERE s.weekend_id = m.id AND s.session_key IS NOT NULL);
@@ -0,0 +1,74 @@
+package model
+
+import "time"
+
+// FantasyDriverPrice es el precio de un piloto en el modo fantasy para una temporada.
+// Se ajusta después de cada carrera según el rendimiento del piloto.
+type FantasyDriverPrice struct {
+	ID        int       `gorm:"primaryKey" json:"id"`
+	DriverID  int       `gorm:"uniqueIndex:idx_fantasy_driver_year;not null" json:"driver_id"`
+	Driver    *Driver   `gorm:"foreignKey:DriverID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Year      int       `gorm:"uniqueIndex:idx_fantasy_driver_year;not null" json:"year"`
+	Price     float64   `gorm:"not null" json:"price"` // En millones
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// FantasyConstructorPrice es el precio de una escudería (identificada por drivers.team_name) en una temporada
+type FantasyConstructorPrice struct {
+	ID        int       `gorm:"primaryKey" json:"id"`
+	TeamName  string    `gorm:"size:100;uniqueIndex:idx_fantasy_constructor_year;not null" json:"team_name"`
+	Year      int       `gorm:"uniqueIndex:idx_fantasy_constructor_year;not null" json:"year"`
+	Price     float64   `gorm:"not null" json:"price"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// FantasyTeam es el equipo fantasy de un usuario para una temporada
+type FantasyTeam struct {
+	ID          int       `gorm:"primaryKey" json:"id"`
+	UserID      int       `gorm:"uniqueIndex:idx_fantasy_user_year;not null" json:"user_id"`
+	User        *User     `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Year        int       `gorm:"uniqueIndex:idx_fantasy_user_year;not null" json:"year"`
+	Name        string    `gorm:"size:100;not null" json:"name"`
+	TotalPoints int       `gorm:"default:0" json:"total_points"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// FantasyLineup es la alineación de un equipo para una carrera. Queda bloqueada con el DateStart de la sesión.
+type FantasyLineup struct {
+	ID              int                   `gorm:"primaryKey" json:"id"`
+	TeamID          int                   `gorm:"uniqueIndex:idx_fantasy_team_session;not null" json:"team_id"`
+	Team            *FantasyTeam          `gorm:"foreignKey:TeamID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	SessionID       int                   `gorm:"uniqueIndex:idx_fantasy_team_session;not null" json:"session_id"`
+	Session         *Session              `gorm:"foreignKey:SessionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	ConstructorName *string               `gorm:"size:100" json:"constructor_name,omitempty"`
+	ConstructorCost float64               `gorm:"default:0" json:"constructor_cost"`
+	Cost            float64               `gorm:"default:0" json:"cost"` // Costo total de la alineación
+	Transfers       int                   `gorm:"default:0" json:"transfers"`
+	Points          int                   `gorm:"default:0" json:"points"`
+	Scored          bool                  `gorm:"default:false" json:"scored"`
+	Drivers         []FantasyLineupDriver `gorm:"foreignKey:LineupID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"drivers"`
+	CreatedAt       time.Time             `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time             `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// FantasyLineupDriver es un piloto dentro de una alineación, con el precio pagado y los puntos obtenidos
+type FantasyLineupDriver struct {
+	ID       int     `gorm:"primaryKey" json:"id"`
+	LineupID int     `gorm:"uniqueIndex:idx_fantasy_lineup_driver;not null" json:"lineup_id"`
+	DriverID int     `gorm:"uniqueIndex:idx_fantasy_lineup_driver;not null" json:"driver_id"`
+	Driver   *Driver `gorm:"foreignKey:DriverID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Price    float64 `gorm:"not null" json:"price"`
+	Points   int     `gorm:"default:0" json:"points"`
+}
+
+// FantasyRound registra las carreras ya procesadas por el job de puntuación fantasy
+type FantasyRound struct {
+	ID        int       `gorm:"primaryKey" json:"id"`
+	SessionID int       `gorm:"uniqueIndex:idx_fantasy_round_session;not null" json:"session_id"`
+	Session   *Session  `gorm:"foreignKey:SessionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Year      int       `gorm:"index;not null" json:"year"`
+	ScoredAt  time.Time `gorm:"autoCreateTime" json:"scored_at"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// Lap es una vuelta de un piloto en una sesión, tal como la informa la API externa
+type Lap struct {
+	ID          int        `gorm:"primaryKey" json:"id"`
+	SessionID   int        `gorm:"uniqueIndex:idx_laps_session_driver_lap;not null" json:"session_id"`
+	Session     *Session   `gorm:"foreignKey:SessionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	DriverID    int        `gorm:"uniqueIndex:idx_laps_session_driver_lap;not null" json:"driver_id"`
+	Driver      *Driver    `gorm:"foreignKey:DriverID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	LapNumber   int        `gorm:"uniqueIndex:idx_laps_session_driver_lap;not null" json:"lap_number"`
+	LapDuration *float64   `json:"lap_duration"` // nil en vueltas sin tiempo (vuelta 1, bandera roja)
+	Sector1     *float64   `json:"sector_1"`
+	Sector2     *float64   `json:"sector_2"`
+	Sector3     *float64   `json:"sector_3"`
+	IsPitInLap  bool       `gorm:"default:false" json:"is_pit_in_lap"`
+	IsPitOutLap bool       `gorm:"default:false" json:"is_pit_out_lap"`
+	DateStart   *time.Time `json:"date_start"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Formatos de fin de semana
+const (
+	MeetingFormatStandard = "standard" // Prácticas, clasificación y carrera
+	MeetingFormatSprint   = "sprint"   // Con clasificación sprint y sprint
+)
+
+// Meeting es un fin de semana de carrera (Gran Premio). Agrupa sus sesiones, que lo referencian por weekend_id.
+type Meeting struct {
+	ID               int            `gorm:"primaryKey" json:"id"`
+	MeetingKey       *int           `gorm:"uniqueIndex:idx_meetings_meeting_key" json:"meeting_key"` // De OpenF1; nil si se cargó a mano
+	Name             string         `gorm:"size:255;not null" json:"name"`                           // Ej: "Australian Grand Prix"
+	CircuitKey       int            `json:"circuit_key"`
+	CircuitShortName string         `json:"circuit_short_name"`
+	CountryCode      string         `json:"country_code"`
+	CountryKey       int            `json:"country_key"`
+	CountryName      string         `json:"country_name"`
+	Location         string         `json:"location"`
+	DateStart        time.Time      `json:"date_start" gorm:"type:timestamp"` // Fechas oficiales del fin de semana
+	DateEnd          time.Time      `json:"date_end" gorm:"type:timestamp"`
+	Year             int            `gorm:"index:idx_meetings_year_date_start" json:"year"`
+	Format           string         `gorm:"size:20;not null;default:standard" json:"format"`
+	Sessions         []Session      `gorm:"foreignKey:WeekendID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"sessions,omitempty"`
+	CreatedAt        time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt        time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt        gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+}
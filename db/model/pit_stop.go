@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// PitStop es una parada en boxes de un piloto en una sesión
+type PitStop struct {
+	ID          int        `gorm:"primaryKey" json:"id"`
+	SessionID   int        `gorm:"uniqueIndex:idx_pit_stops_session_driver_lap;not null" json:"session_id"`
+	Session     *Session   `gorm:"foreignKey:SessionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	DriverID    int        `gorm:"uniqueIndex:idx_pit_stops_session_driver_lap;not null" json:"driver_id"`
+	Driver      *Driver    `gorm:"foreignKey:DriverID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	LapNumber   int        `gorm:"uniqueIndex:idx_pit_stops_session_driver_lap;not null" json:"lap_number"`
+	PitDuration *float64   `json:"pit_duration"` // Segundos en el pit lane; nil si la API no lo informa
+	Date        *time.Time `json:"date"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Tipos de sesión que suman puntos para el campeonato
+const (
+	PointsSessionRace   = "race"
+	PointsSessionSprint = "sprint"
+)
+
+// PointsSystem es la tabla de puntos del campeonato para un tipo de sesión.
+// Rige desde la temporada Year hasta que otra fila con un año mayor la reemplace.
+type PointsSystem struct {
+	ID                    int       `gorm:"primaryKey" json:"id"`
+	Year                  int       `gorm:"uniqueIndex:idx_points_systems_year_kind;not null" json:"year"`
+	SessionKind           string    `gorm:"size:20;uniqueIndex:idx_points_systems_year_kind;not null" json:"session_kind"`
+	Points                string    `gorm:"size:100;not null" json:"points"`            // Puntos por posición separados por coma: "25,18,15,..."
+	FastestLapPoints      int       `gorm:"default:0" json:"fastest_lap_points"`        // 0 = la vuelta rápida no suma
+	FastestLapMaxPosition int       `gorm:"default:10" json:"fastest_lap_max_position"` // Posición máxima para cobrar la vuelta rápida
+	CreatedAt             time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt             time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
@@ -0,0 +1,53 @@
+package model
+
+import "time"
+
+// Tipos de respuesta que puede tener un prop
+const (
+	PropAnswerBoolean = "boolean" // "true" / "false"
+	PropAnswerInteger = "integer" // Número entero (ej: cantidad de pit stops)
+	PropAnswerNumber  = "number"  // Número decimal (ej: margen de victoria en segundos)
+	PropAnswerDriver  = "driver"  // driver_id (0 = ninguno)
+)
+
+// Prop es una predicción extra de carrera definida en base de datos.
+// Agregar un prop nuevo no requiere cambiar el esquema: se crea desde el admin de prodes y su valor real
+// se carga a mano en results, salvo que tenga un resolver automático registrado por Code.
+type Prop struct {
+	ID          int       `gorm:"primaryKey" json:"id"`
+	Code        string    `gorm:"size:50;uniqueIndex;not null" json:"code"`
+	Name        string    `gorm:"size:100;not null" json:"name"`
+	Description string    `gorm:"size:255" json:"description"`
+	AnswerType  string    `gorm:"size:20;not null" json:"answer_type"`
+	Points      int       `gorm:"default:0" json:"points"`
+	Buckets     string    `gorm:"size:255" json:"buckets,omitempty"` // Límites inferiores de los rangos de respuestas numéricas separados por coma (ej: "0,20,25,30")
+	Active      bool      `gorm:"default:true" json:"active"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// ProdeCarreraProp es la respuesta de un usuario a un prop dentro de su ProdeCarrera
+type ProdeCarreraProp struct {
+	ID             int           `gorm:"primaryKey" json:"id"`
+	ProdeCarreraID int           `gorm:"index;not null" json:"prode_carrera_id"`
+	ProdeCarrera   *ProdeCarrera `gorm:"foreignKey:ProdeCarreraID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	PropID         int           `gorm:"index;not null" json:"prop_id"`
+	Prop           *Prop         `gorm:"foreignKey:PropID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Answer         string        `gorm:"size:100;not null" json:"answer"`
+	Score          int           `gorm:"default:0" json:"score"`
+	CreatedAt      time.Time     `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time     `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// SessionPropResult es el valor real de un prop en una sesión, resuelto a partir de resultados y race control
+type SessionPropResult struct {
+	ID        int       `gorm:"primaryKey" json:"id"`
+	SessionID int       `gorm:"uniqueIndex:idx_session_prop;not null" json:"session_id"`
+	Session   *Session  `gorm:"foreignKey:SessionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	PropID    int       `gorm:"uniqueIndex:idx_session_prop;not null" json:"prop_id"`
+	Prop      *Prop     `gorm:"foreignKey:PropID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Answer    string    `gorm:"size:100;not null" json:"answer"`
+	Manual    bool      `gorm:"default:false" json:"manual"` // Cargado por un admin: el resolver automático no lo pisa
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
@@ -13,4 +13,20 @@ type Result struct {
 	CreatedAt      time.Time `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt      time.Time `gorm:"autoUpdateTime" json:"updated_at"`
 	Status         string    `gorm:"type:longtext" json:"status"`
+
+	// Clasificación detallada (se completa en la ingesta desde la API externa)
+	GridPosition    *int     `json:"grid_position"`     // Posición de largada
+	LapsCompleted   int      `json:"laps_completed"`    // Vueltas completadas
+	TotalTime       *float64 `json:"total_time"`        // Tiempo total de carrera en segundos
+	GapToLeader     *float64 `json:"gap_to_leader"`     // Segundos detrás del ganador (nil si está a una o más vueltas); en qualy, de la pole
+	IntervalToAhead *float64 `json:"interval_to_ahead"` // Segundos detrás del auto de adelante
+	LapsBehind      int      `json:"laps_behind"`       // Vueltas de diferencia con el ganador ("+1 Lap")
+
+	Points float64 `gorm:"default:0" json:"points"` // Puntos del campeonato obtenidos en la sesión
+
+	// Tandas de la clasificación (qualy y sprint qualy): mejor vuelta de cada una y última tanda disputada
+	Q1Time          *float64 `gorm:"column:q1_time" json:"q1_time"`
+	Q2Time          *float64 `gorm:"column:q2_time" json:"q2_time"`
+	Q3Time          *float64 `gorm:"column:q3_time" json:"q3_time"`
+	KnockoutSegment string   `gorm:"type:varchar(2)" json:"knockout_segment"` // Q1 o Q2 si quedó eliminado ahí, Q3 si llegó a la última tanda
 }
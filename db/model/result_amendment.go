@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// Estados del ciclo de vida de los resultados de una sesión
+const (
+	ResultStatusProvisional = "provisional" // Cargados, todavía pueden corregirse libremente
+	ResultStatusOfficial    = "official"    // Confirmados; a partir de acá los prodes se puntúan
+	ResultStatusAmended     = "amended"     // Oficiales con al menos una enmienda posterior
+)
+
+// SessionResultStatus es el estado de la clasificación de una sesión
+type SessionResultStatus struct {
+	ID         int        `gorm:"primaryKey" json:"id"`
+	SessionID  int        `gorm:"uniqueIndex;not null" json:"session_id"`
+	Session    *Session   `gorm:"foreignKey:SessionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Status     string     `gorm:"size:20;not null;default:provisional" json:"status"`
+	OfficialAt *time.Time `json:"official_at,omitempty"`
+	AmendedAt  *time.Time `json:"amended_at,omitempty"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// ResultAmendment es un cambio a un resultado oficial (penalización, descalificación, etc.), con el valor previo y el nuevo
+type ResultAmendment struct {
+	ID                 int       `gorm:"primaryKey" json:"id"`
+	SessionID          int       `gorm:"index;not null" json:"session_id"`
+	ResultID           int       `gorm:"index;not null" json:"result_id"`
+	Result             *Result   `gorm:"foreignKey:ResultID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	DriverID           int       `gorm:"not null" json:"driver_id"`
+	PreviousPosition   *int      `json:"previous_position"`
+	NewPosition        *int      `json:"new_position"`
+	PreviousStatus     string    `gorm:"size:20" json:"previous_status"`
+	NewStatus          string    `gorm:"size:20" json:"new_status"`
+	PreviousFastestLap float64   `json:"previous_fastest_lap"`
+	NewFastestLap      float64   `json:"new_fastest_lap"`
+	Reason             string    `gorm:"type:text;not null" json:"reason"`
+	CreatedAt          time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// Modos de juego soportados por un ruleset
+const (
+	RulesetModeClassic    = "classic"    // Puntaje fijo por acierto (3/1, VSC, SC, DNF)
+	RulesetModeConfidence = "confidence" // El usuario reparte un presupuesto de puntos de confianza
+)
+
+// Ruleset define cómo se juega y puntúa un pronóstico de carrera
+type Ruleset struct {
+	ID               int       `gorm:"primaryKey" json:"id"`
+	Name             string    `gorm:"size:100;uniqueIndex;not null" json:"name"`
+	Mode             string    `gorm:"size:20;not null;default:classic" json:"mode"`
+	ConfidenceBudget int       `gorm:"default:10" json:"confidence_budget"` // Puntos a repartir en modo confidence
+	MaxPerPick       int       `gorm:"default:0" json:"max_per_pick"`       // Máximo por pick, 0 = sin límite
+	Active           bool      `gorm:"default:true" json:"active"`
+	CreatedAt        time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
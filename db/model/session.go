@@ -8,7 +8,7 @@ import (
 
 type Session struct {
 	ID               int            `gorm:"primaryKey" json:"id"`
-	WeekendID        int            `json:"weekend_id"`
+	WeekendID        *int           `json:"weekend_id"`
 	CircuitKey       int            `json:"circuit_key"`
 	CircuitShortName string         `json:"circuit_short_name"`
 	CountryCode      string         `json:"country_code"`
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// Estados de la ingesta automática de resultados de una sesión
+const (
+	IngestionStatusPending   = "pending"   // Esperando (primer intento o reintento programado)
+	IngestionStatusRunning   = "running"   // En curso
+	IngestionStatusSucceeded = "succeeded" // Resultados cargados
+	IngestionStatusFailed    = "failed"    // Se agotaron los reintentos, requiere intervención de un admin
+)
+
+// SessionIngestion registra el estado de la carga automática de resultados de una sesión
+type SessionIngestion struct {
+	ID            int        `gorm:"primaryKey" json:"id"`
+	SessionID     int        `gorm:"uniqueIndex;not null" json:"session_id"`
+	Session       *Session   `gorm:"foreignKey:SessionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Status        string     `gorm:"size:20;not null;default:pending;index" json:"status"`
+	Attempts      int        `gorm:"default:0" json:"attempts"`
+	LastError     string     `gorm:"type:text" json:"last_error,omitempty"`
+	ResultsCount  int        `gorm:"default:0" json:"results_count"`
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
@@ -0,0 +1,59 @@
+package model
+
+import "time"
+
+// Estados de un participante en modo survivor
+const (
+	SurvivorStatusAlive      = "alive"
+	SurvivorStatusEliminated = "eliminated"
+	SurvivorStatusWinner     = "winner"
+)
+
+// Estados de un pick en modo survivor
+const (
+	SurvivorPickPending  = "pending"
+	SurvivorPickSurvived = "survived"
+	SurvivorPickMissed   = "missed"
+	SurvivorPickVoid     = "void" // Anulado: el participante ya no estaba en juego al resolverse la carrera
+)
+
+// SurvivorLeague es una competencia survivor de un grupo para una temporada
+type SurvivorLeague struct {
+	ID           int       `gorm:"primaryKey" json:"id"`
+	GroupID      int       `gorm:"uniqueIndex:idx_survivor_group_year;not null" json:"group_id"`
+	Group        *Group    `gorm:"foreignKey:GroupID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Year         int       `gorm:"uniqueIndex:idx_survivor_group_year;not null" json:"year"`
+	TopN         int       `gorm:"default:10" json:"top_n"` // El piloto elegido debe terminar dentro de este top
+	Finished     bool      `gorm:"default:false" json:"finished"`
+	WinnerUserID *int      `json:"winner_user_id,omitempty"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// SurvivorEntry es la participación de un usuario en una liga survivor
+type SurvivorEntry struct {
+	ID                  int             `gorm:"primaryKey" json:"id"`
+	LeagueID            int             `gorm:"uniqueIndex:idx_survivor_league_user;not null" json:"league_id"`
+	League              *SurvivorLeague `gorm:"foreignKey:LeagueID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	UserID              int             `gorm:"uniqueIndex:idx_survivor_league_user;not null" json:"user_id"`
+	User                *User           `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Status              string          `gorm:"size:20;not null;default:alive" json:"status"`
+	EliminatedSessionID *int            `json:"eliminated_session_id,omitempty"`
+	CreatedAt           time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt           time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// SurvivorPick es el piloto elegido por un participante para una carrera.
+// Un piloto no puede repetirse dentro de la misma entry (temporada).
+type SurvivorPick struct {
+	ID        int            `gorm:"primaryKey" json:"id"`
+	EntryID   int            `gorm:"uniqueIndex:idx_survivor_entry_session;uniqueIndex:idx_survivor_entry_driver;not null" json:"entry_id"`
+	Entry     *SurvivorEntry `gorm:"foreignKey:EntryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	SessionID int            `gorm:"uniqueIndex:idx_survivor_entry_session;not null" json:"session_id"`
+	Session   *Session       `gorm:"foreignKey:SessionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	DriverID  int            `gorm:"uniqueIndex:idx_survivor_entry_driver;not null" json:"driver_id"`
+	Driver    *Driver        `gorm:"foreignKey:DriverID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Result    string         `gorm:"size:20;not null;default:pending" json:"result"`
+	CreatedAt time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+}
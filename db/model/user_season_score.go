@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// UserSeasonScore es el puntaje de un usuario en una temporada.
+// User.Score sigue siendo el acumulado histórico; el ranking por temporada se arma desde esta tabla.
+type UserSeasonScore struct {
+	ID        int       `gorm:"primaryKey" json:"id"`
+	UserID    int       `gorm:"uniqueIndex:idx_user_season;not null" json:"user_id"`
+	User      *User     `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Year      int       `gorm:"uniqueIndex:idx_user_season;index:idx_season_score,priority:1;not null" json:"year"`
+	Score     int       `gorm:"default:0;index:idx_season_score,priority:2" json:"score"`
+	Position  *int      `json:"position,omitempty"` // Posición final, se completa al archivar la temporada
+	Archived  bool      `gorm:"default:false" json:"archived"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// WeatherSample es una medición del clima en el circuito durante una sesión, tal como la informa la API externa
+type WeatherSample struct {
+	ID               int       `gorm:"primaryKey" json:"id"`
+	SessionID        int       `gorm:"uniqueIndex:idx_weather_samples_session_date;not null" json:"session_id"`
+	Session          *Session  `gorm:"foreignKey:SessionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Date             time.Time `gorm:"uniqueIndex:idx_weather_samples_session_date;not null" json:"date"`
+	AirTemperature   float64   `json:"air_temperature"`   // °C
+	TrackTemperature float64   `json:"track_temperature"` // °C
+	Humidity         float64   `json:"humidity"`          // %
+	Pressure         float64   `json:"pressure"`          // mbar
+	Rainfall         bool      `gorm:"default:false" json:"rainfall"`
+	WindDirection    int       `json:"wind_direction"` // Grados
+	WindSpeed        float64   `json:"wind_speed"`     // m/s
+	CreatedAt        time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
@@ -9,10 +9,10 @@ import (
 
 	"prediapp.local/db"
 	"prediapp.local/drivers/internal/api"
-	"prediapp.local/drivers/internal/client"
 	"prediapp.local/drivers/internal/repository"
 	"prediapp.local/drivers/internal/router"
 	"prediapp.local/drivers/internal/service"
+	"prediapp.local/racedata"
 
 	"github.com/gin-gonic/gin"
 )
@@ -34,12 +34,15 @@ func main() {
 		log.Fatalf("db.Init failed: %v", err)
 	}
 
-	// 3) Cliente HTTP externo
-	externalAPI := client.NewHttpClient("https://api.openf1.org/v1/")
+	// 3) Fuente de datos de carrera (OpenF1 o fixtures en disco según RACE_DATA_PROVIDER)
+	raceData, err := racedata.NewProviderFromEnv()
+	if err != nil {
+		log.Fatalf("race data provider: %v", err)
+	}
 
 	// 4) Repos, servicio y controlador
 	dRepo := repository.NewDriverRepository(db.DB)
-	dService := service.NewDriverService(dRepo, externalAPI)
+	dService := service.NewDriverService(dRepo, raceData)
 	dController := api.NewDriverController(dService)
 
 	// 5) Router
@@ -113,7 +113,8 @@ func (r *driverRepository) GetDriverByName(ctx context.Context, firstName string
 
 func (r *driverRepository) GetDriverByNumber(ctx context.Context, driverNumber int) (*model.Driver, e.ApiError) {
 	var driver model.Driver
-	err := r.db.WithContext(ctx).Where("driver_number = ?", driverNumber).First(&driver).Error
+	// Si el número se repite entre temporadas, primero el piloto activo
+	err := r.db.WithContext(ctx).Where("driver_number = ?", driverNumber).Order("activo DESC").First(&driver).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, e.NewNotFoundApiError(fmt.Sprintf("Piloto con número %d no encontrado", driverNumber))
@@ -6,15 +6,15 @@ import (
 	"log"
 
 	model "prediapp.local/db/model"
-	"prediapp.local/drivers/internal/client"
 	dto "prediapp.local/drivers/internal/dto"
 	repository "prediapp.local/drivers/internal/repository"
 	e "prediapp.local/drivers/pkg/utils"
+	"prediapp.local/racedata"
 )
 
 type driverService struct {
 	driverRepo repository.DriverRepository
-	client     *client.HttpClient
+	raceData   racedata.RaceDataProvider
 }
 
 type DriverService interface {
@@ -32,10 +32,10 @@ type DriverService interface {
 	GetDriverByFirstAndLastName(ctx context.Context, firstName, lastName string) (dto.ResponseDriverDTO, e.ApiError)
 }
 
-func NewDriverService(driverRepo repository.DriverRepository, client *client.HttpClient) DriverService {
+func NewDriverService(driverRepo repository.DriverRepository, raceData racedata.RaceDataProvider) DriverService {
 	return &driverService{
 		driverRepo: driverRepo,
-		client:     client, // Pasar el cliente HTTP
+		raceData:   raceData,
 	}
 }
 
@@ -46,9 +46,10 @@ func (s *driverService) CreateDriver(ctx context.Context, request dto.CreateDriv
 		return dto.ResponseDriverDTO{}, e.NewBadRequestApiError("Ya existe un piloto con el mismo nombre y apellido")
 	}
 
-	// Verificar si el número de piloto ya está en uso
+	// Verificar si el número de piloto ya está en uso. Los pilotos inactivos (temporadas pasadas) pueden
+	// repetir el número de un piloto actual
 	driverWithNumber, _ := s.driverRepo.GetDriverByNumber(ctx, request.DriverNumber)
-	if driverWithNumber != nil {
+	if driverWithNumber != nil && driverWithNumber.Activo && request.Activo {
 		return dto.ResponseDriverDTO{}, e.NewBadRequestApiError("Ya existe un piloto con el mismo número")
 	}
 
@@ -321,12 +322,28 @@ func (s *driverService) ListDriversByAcronym(ctx context.Context, acronym string
 }
 
 func (s *driverService) FetchAllDriversFromExternalAPI(ctx context.Context) ([]dto.ResponseDriverDTO, e.ApiError) {
-	// 1. Obtener los pilotos desde la API externa
-	drivers, err := s.client.GetAllDriversFromExternalAPI()
+	// 1. Obtener los pilotos desde la fuente de datos de carrera (todas las sesiones)
+	externalDrivers, err := s.raceData.GetDrivers(0)
 	if err != nil {
 		return nil, e.NewInternalServerApiError("Error fetching drivers from external API", err)
 	}
 
+	drivers := make([]dto.ResponseDriverDTO, 0, len(externalDrivers))
+	for _, driver := range externalDrivers {
+		drivers = append(drivers, dto.ResponseDriverDTO{
+			BroadcastName: driver.BroadcastName,
+			CountryCode:   driver.CountryCode,
+			DriverNumber:  driver.DriverNumber,
+			FirstName:     driver.FirstName,
+			LastName:      driver.LastName,
+			FullName:      driver.FullName,
+			NameAcronym:   driver.NameAcronym,
+			HeadshotURL:   driver.HeadshotURL,
+			TeamName:      driver.TeamName,
+			Activo:        false,
+		})
+	}
+
 	// 2. Eliminar duplicados por 'FirstName y LastName'
 	uniqueDrivers := uniqueDrivers(drivers)
 
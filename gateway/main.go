@@ -73,6 +73,9 @@ func main() {
 		api.Any("/sessions", proxy.ReverseProxy())
 		api.Any("/sessions/*proxyPath", proxy.ReverseProxy())
 
+		api.Any("/meetings", proxy.ReverseProxy())
+		api.Any("/meetings/*proxyPath", proxy.ReverseProxy())
+
 		api.Any("/groups", proxy.ReverseProxy())
 		api.Any("/groups/*proxyPath", proxy.ReverseProxy())
 
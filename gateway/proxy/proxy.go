@@ -103,7 +103,7 @@ func getTargetURL(path string) (string, string) {
 		return os.Getenv("PRODES_SERVICE_URL"), proxyPath
 	case "results":
 		return os.Getenv("RESULTS_SERVICE_URL"), proxyPath
-	case "sessions":
+	case "sessions", "meetings":
 		return os.Getenv("SESSIONS_SERVICE_URL"), proxyPath
 	case "groups":
 		return os.Getenv("GROUPS_SERVICE_URL"), proxyPath
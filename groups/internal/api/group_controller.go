@@ -4,6 +4,7 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"prediapp.local/groups/internal/dto"
 	"prediapp.local/groups/internal/service"
@@ -49,7 +50,14 @@ func (ctrl *GroupController) GetGroupByID(c *gin.Context) {
 		return
 	}
 
-	response, apiErr := ctrl.groupService.GetGroupByID(c.Request.Context(), id)
+	season, err := seasonParam(c)
+	if err != nil {
+		apiErr := e.NewBadRequestApiError("Invalid season")
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	response, apiErr := ctrl.groupService.GetGroupByID(c.Request.Context(), id, season)
 	if apiErr != nil {
 		c.JSON(apiErr.Status(), apiErr)
 		return
@@ -66,7 +74,14 @@ func (ctrl *GroupController) GetGroupsByUserId(c *gin.Context) {
 		return
 	}
 
-	responses, apiErr := ctrl.groupService.GetGroupsByUserId(c.Request.Context(), userID)
+	season, err := seasonParam(c)
+	if err != nil {
+		apiErr := e.NewBadRequestApiError("Invalid season")
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	responses, apiErr := ctrl.groupService.GetGroupsByUserId(c.Request.Context(), userID, season)
 	if apiErr != nil {
 		c.JSON(apiErr.Status(), apiErr)
 		return
@@ -154,3 +169,12 @@ func (ctrl *GroupController) GetJoinRequests(c *gin.Context) {
 	// result es dto.GroupJoinRequests
 	c.JSON(http.StatusOK, result)
 }
+
+// seasonParam lee el query param `season`; por defecto la temporada en curso
+func seasonParam(c *gin.Context) (int, error) {
+	season := c.Query("season")
+	if season == "" {
+		return time.Now().Year(), nil
+	}
+	return strconv.Atoi(season)
+}
@@ -31,9 +31,9 @@ func NewUsersClient() *UsersClient {
 	}
 }
 
-// GetUserScore obtiene el puntaje de un usuario desde el microservicio de users
-func (c *UsersClient) GetUserScore(userID int) (int, error) {
-	url := fmt.Sprintf("%s/users/%d", c.BaseURL, userID) // Endpoint actualizado
+// GetUserScore obtiene el puntaje de un usuario en una temporada desde el microservicio de users
+func (c *UsersClient) GetUserScore(userID int, season int) (int, error) {
+	url := fmt.Sprintf("%s/users/%d/score?season=%d", c.BaseURL, userID, season)
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -17,8 +17,8 @@ type groupService struct {
 
 type GroupServiceInterface interface {
 	CreateGroup(ctx context.Context, group dto.CreateGroupRequestDTO) (dto.GroupResponseDTO, e.ApiError)
-	GetGroupByID(ctx context.Context, id int) (dto.GroupResponseDTO, e.ApiError)
-	GetGroupsByUserId(ctx context.Context, userID int) ([]dto.GroupResponseDTO, e.ApiError)
+	GetGroupByID(ctx context.Context, id int, season int) (dto.GroupResponseDTO, e.ApiError)
+	GetGroupsByUserId(ctx context.Context, userID int, season int) ([]dto.GroupResponseDTO, e.ApiError)
 	GetGroups(ctx context.Context) ([]dto.GroupListResponseDTO, e.ApiError)
 	DeleteGroupByID(ctx context.Context, id int) e.ApiError
 	JoinGroup(ctx context.Context, request dto.RequestJoinGroupDTO) e.ApiError
@@ -71,7 +71,7 @@ func (s *groupService) CreateGroup(ctx context.Context, request dto.CreateGroupR
 	return response, nil
 }
 
-func (s *groupService) GetGroupsByUserId(ctx context.Context, userID int) ([]dto.GroupResponseDTO, e.ApiError) {
+func (s *groupService) GetGroupsByUserId(ctx context.Context, userID int, season int) ([]dto.GroupResponseDTO, e.ApiError) {
 	groups, apiErr := s.groupRepo.GetGroupsByUserId(ctx, userID)
 	if apiErr != nil {
 		return nil, apiErr
@@ -84,7 +84,7 @@ func (s *groupService) GetGroupsByUserId(ctx context.Context, userID int) ([]dto
 		var users []dto.GroupUserResponseDTO
 
 		for _, gu := range g.GroupUsers {
-			score, err := usersClient.GetUserScore(gu.UserID)
+			score, err := usersClient.GetUserScore(gu.UserID, season)
 			if err != nil {
 				score = 0
 			}
@@ -109,7 +109,7 @@ func (s *groupService) GetGroupsByUserId(ctx context.Context, userID int) ([]dto
 	return responses, nil
 }
 
-func (s *groupService) GetGroupByID(ctx context.Context, id int) (dto.GroupResponseDTO, e.ApiError) {
+func (s *groupService) GetGroupByID(ctx context.Context, id int, season int) (dto.GroupResponseDTO, e.ApiError) {
 	group, err := s.groupRepo.GetGroupByID(ctx, id)
 	if err != nil {
 		return dto.GroupResponseDTO{}, err
@@ -120,7 +120,7 @@ func (s *groupService) GetGroupByID(ctx context.Context, id int) (dto.GroupRespo
 	// Mapear usuarios del grupo con sus puntajes
 	var users []dto.GroupUserResponseDTO
 	for _, groupUser := range group.GroupUsers {
-		score, err := usersClient.GetUserScore(groupUser.UserID)
+		score, err := usersClient.GetUserScore(groupUser.UserID, season)
 		if err != nil {
 			score = 0 // Si falla, asignamos 0 por defecto
 		}
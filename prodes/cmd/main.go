@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 
 	"prediapp.local/db"
 	"prediapp.local/prodes/internal/api"
@@ -51,9 +54,33 @@ func main() {
 	pService := service.NewPrediService(pRepo, sessionClient, userClient, driverClient, resultsClient)
 	pCtrl := api.NewProdeController(pService)
 
+	sRepo := repository.NewSurvivorRepository(db.DB)
+	sService := service.NewSurvivorService(sRepo, sessionClient, resultsClient)
+	sCtrl := api.NewSurvivorController(sService)
+
+	// Job que resuelve los picks survivor una vez cargados los resultados
+	jobCtx, cancelJobs := context.WithCancel(context.Background())
+	defer cancelJobs()
+	go sService.StartResolutionJob(jobCtx, jobInterval("SURVIVOR_JOB_INTERVAL", 10*time.Minute))
+
+	fRepo := repository.NewFantasyRepository(db.DB)
+	fService := service.NewFantasyService(fRepo, sessionClient)
+	fCtrl := api.NewFantasyController(fService)
+
+	// Job que puntúa las alineaciones fantasy y ajusta precios luego de cada carrera
+	go fService.StartScoringJob(jobCtx, jobInterval("FANTASY_JOB_INTERVAL", 10*time.Minute))
+
+	scRepo := repository.NewScoreRepository(db.DB)
+	scService := service.NewScoreService(scRepo)
+	scCtrl := api.NewScoreController(scService)
+
+	// Job de reconciliación de puntajes; solo corrige si SCORE_RECONCILIATION_FIX=true
+	fixScores, _ := strconv.ParseBool(os.Getenv("SCORE_RECONCILIATION_FIX"))
+	go scService.StartReconciliationJob(jobCtx, jobInterval("SCORE_RECONCILIATION_INTERVAL", 24*time.Hour), fixScores)
+
 	// 5) Router
 	r := gin.Default()
-	router.MapUrls(r, pCtrl)
+	router.MapUrls(r, pCtrl, sCtrl, fCtrl, scCtrl)
 
 	// 6) Servir
 	port := os.Getenv("PORT")
@@ -76,3 +103,17 @@ func main() {
 	// 	log.Printf("Clave: %s, Expiración: %s, Valor: %+v\n", entry.Key, entry.Expiration.Format(time.RFC3339), entry.Value)
 	// }
 }
+
+// jobInterval lee el intervalo de un job desde el entorno (formato time.ParseDuration)
+func jobInterval(envVar string, def time.Duration) time.Duration {
+	v := os.Getenv(envVar)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		log.Printf("%s inválido (%s), usando %s", envVar, v, def)
+		return def
+	}
+	return d
+}
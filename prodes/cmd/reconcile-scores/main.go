@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"prediapp.local/db"
+	"prediapp.local/prodes/internal/repository"
+	"prediapp.local/prodes/internal/service"
+)
+
+// Comando para verificar que users.score y user_season_scores coincidan con la suma de los prodes.
+// Uso: go run ./cmd/reconcile-scores [-fix]
+func main() {
+	fix := flag.Bool("fix", false, "corregir los puntajes con diferencias")
+	flag.Parse()
+
+	for _, v := range []string{"DB_HOST", "DB_PORT", "DB_USER", "DB_PASS", "DB_NAME"} {
+		if os.Getenv(v) == "" {
+			log.Fatalf("%s no está definida", v)
+		}
+	}
+
+	if err := db.Init(); err != nil {
+		log.Fatalf("db.Init failed: %v", err)
+	}
+	defer db.DisconnectDB()
+
+	scoreService := service.NewScoreService(repository.NewScoreRepository(db.DB))
+
+	report, apiErr := scoreService.ReconcileScores(context.Background(), *fix)
+	if apiErr != nil {
+		log.Fatalf("Score reconciliation failed: %v", apiErr)
+	}
+
+	for _, d := range report.Discrepancies {
+		if d.Season != nil {
+			log.Printf("user %d (%s) temporada %d: guardado %d, calculado %d (diferencia %d)",
+				d.UserID, d.Username, *d.Season, d.StoredScore, d.ComputedScore, d.Difference)
+			continue
+		}
+		log.Printf("user %d (%s): guardado %d, calculado %d (diferencia %d)",
+			d.UserID, d.Username, d.StoredScore, d.ComputedScore, d.Difference)
+	}
+	log.Printf("Usuarios revisados: %d, con diferencias: %d, temporadas con diferencias: %d, drift total: %d, corregido: %t",
+		report.UsersChecked, report.UserDiscrepancies, report.SeasonDiscrepancies, report.TotalDrift, report.Fixed)
+}
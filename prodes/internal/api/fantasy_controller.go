@@ -0,0 +1,152 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	dto "prediapp.local/prodes/internal/dto"
+	prodes "prediapp.local/prodes/internal/service"
+	e "prediapp.local/prodes/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FantasyController struct {
+	fantasyService prodes.FantasyServiceInterface
+}
+
+func NewFantasyController(fantasyService prodes.FantasyServiceInterface) *FantasyController {
+	return &FantasyController{
+		fantasyService: fantasyService,
+	}
+}
+
+func (c *FantasyController) GetPrices(ctx *gin.Context) {
+	year, err := queryInt(ctx, "year")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid year"))
+		return
+	}
+
+	response, apiErr := c.fantasyService.GetPrices(ctx.Request.Context(), year)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *FantasyController) SetPrice(ctx *gin.Context) {
+	var request dto.SetFantasyPriceDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid JSON data"))
+		return
+	}
+
+	if apiErr := c.fantasyService.SetPrice(ctx.Request.Context(), request); apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Fantasy price updated successfully"})
+}
+
+func (c *FantasyController) CreateTeam(ctx *gin.Context) {
+	var request dto.CreateFantasyTeamDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid JSON data"))
+		return
+	}
+
+	response, apiErr := c.fantasyService.CreateTeam(ctx.Request.Context(), request)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *FantasyController) GetTeam(ctx *gin.Context) {
+	teamID, err := strconv.Atoi(ctx.Param("team_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid team ID"))
+		return
+	}
+
+	response, apiErr := c.fantasyService.GetTeam(ctx.Request.Context(), teamID)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *FantasyController) UpdateLineup(ctx *gin.Context) {
+	teamID, err := strconv.Atoi(ctx.Param("team_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid team ID"))
+		return
+	}
+
+	var request dto.UpdateFantasyLineupDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid JSON data"))
+		return
+	}
+
+	response, apiErr := c.fantasyService.UpdateLineup(ctx.Request.Context(), teamID, request)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *FantasyController) ScoreSession(ctx *gin.Context) {
+	sessionID, err := strconv.Atoi(ctx.Param("session_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid session ID"))
+		return
+	}
+
+	if apiErr := c.fantasyService.ScoreSession(ctx.Request.Context(), sessionID); apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Fantasy scores updated successfully"})
+}
+
+func (c *FantasyController) GetLeaderboard(ctx *gin.Context) {
+	year, err := queryInt(ctx, "year")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid year"))
+		return
+	}
+	groupID, err := queryInt(ctx, "group_id")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid group ID"))
+		return
+	}
+
+	response, apiErr := c.fantasyService.GetLeaderboard(ctx.Request.Context(), year, groupID)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// queryInt lee un query param entero opcional (0 si no viene)
+func queryInt(ctx *gin.Context, key string) (int, error) {
+	value := ctx.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
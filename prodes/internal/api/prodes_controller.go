@@ -398,6 +398,76 @@ func (c *ProdeController) UpdateScoresForSession(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{"message": "Scores updated successfully"})
 }
 
+// PreviewScoresForRace simula el recálculo de los prodes de carrera con el top 5 recibido, sin guardar
+func (c *ProdeController) PreviewScoresForRace(ctx *gin.Context) {
+	sessionID, err := strconv.Atoi(ctx.Param("session_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid session_id parameter"))
+		return
+	}
+
+	var request dto.ScorePreviewRequestDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid JSON data"))
+		return
+	}
+
+	preview, apiErr := c.prodeService.PreviewRaceScores(ctx.Request.Context(), sessionID, request.TopDrivers)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, preview)
+}
+
+// PreviewScoresForSession simula el recálculo de los prodes de sesión con el top 3 recibido, sin guardar
+func (c *ProdeController) PreviewScoresForSession(ctx *gin.Context) {
+	sessionID, err := strconv.Atoi(ctx.Param("session_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid session_id parameter"))
+		return
+	}
+
+	var request dto.ScorePreviewRequestDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid JSON data"))
+		return
+	}
+
+	preview, apiErr := c.prodeService.PreviewSessionScores(ctx.Request.Context(), sessionID, request.TopDrivers)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, preview)
+}
+
+// GetLiveScores devuelve los puntajes provisorios de los prodes de una sesión en curso (?user_id= para uno solo)
+func (c *ProdeController) GetLiveScores(ctx *gin.Context) {
+	sessionID, err := strconv.Atoi(ctx.Param("session_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid session_id parameter"))
+		return
+	}
+	userID := 0
+	if value := ctx.Query("user_id"); value != "" {
+		if userID, err = strconv.Atoi(value); err != nil {
+			ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid user_id parameter"))
+			return
+		}
+	}
+
+	scores, apiErr := c.prodeService.GetLiveScores(ctx.Request.Context(), sessionID, userID)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, scores)
+}
+
 // func (c *ProdeController) UpdateUserScores(ctx *gin.Context) {
 // 	if apiErr := c.prodeService.UpdateUserScores(ctx.Request.Context()); apiErr != nil {
 // 		ctx.JSON(apiErr.Status(), apiErr)
@@ -405,3 +475,92 @@ func (c *ProdeController) UpdateScoresForSession(ctx *gin.Context) {
 // 	}
 // 	ctx.JSON(http.StatusOK, gin.H{"message": "Puntajes de los usuarios actualizados correctamente"})
 // }
+
+func (c *ProdeController) CreateRuleset(ctx *gin.Context) {
+	var request dto.CreateRulesetDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid JSON data"))
+		return
+	}
+
+	response, apiErr := c.prodeService.CreateRuleset(ctx.Request.Context(), request)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *ProdeController) GetRulesets(ctx *gin.Context) {
+	rulesets, apiErr := c.prodeService.GetRulesets(ctx.Request.Context())
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rulesets)
+}
+
+func (c *ProdeController) GetProps(ctx *gin.Context) {
+	props, apiErr := c.prodeService.GetProps(ctx.Request.Context())
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, props)
+}
+
+func (c *ProdeController) CreateProp(ctx *gin.Context) {
+	var request dto.CreatePropDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid JSON data"))
+		return
+	}
+
+	response, apiErr := c.prodeService.CreateProp(ctx.Request.Context(), request)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *ProdeController) UpdateProp(ctx *gin.Context) {
+	propID, err := strconv.Atoi(ctx.Param("prop_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid prop_id parameter"))
+		return
+	}
+
+	var request dto.UpdatePropDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid JSON data"))
+		return
+	}
+
+	response, apiErr := c.prodeService.UpdateProp(ctx.Request.Context(), propID, request)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *ProdeController) DeleteProp(ctx *gin.Context) {
+	propID, err := strconv.Atoi(ctx.Param("prop_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid prop_id parameter"))
+		return
+	}
+
+	if apiErr := c.prodeService.DeleteProp(ctx.Request.Context(), propID); apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	prodes "prediapp.local/prodes/internal/service"
+	e "prediapp.local/prodes/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ScoreController struct {
+	scoreService prodes.ScoreServiceInterface
+}
+
+func NewScoreController(scoreService prodes.ScoreServiceInterface) *ScoreController {
+	return &ScoreController{
+		scoreService: scoreService,
+	}
+}
+
+// GetReconciliationSummary recalcula los puntajes sin modificar nada y devuelve las diferencias
+func (c *ScoreController) GetReconciliationSummary(ctx *gin.Context) {
+	report, apiErr := c.scoreService.ReconcileScores(ctx.Request.Context(), false)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+// ReconcileScores corre la reconciliación; con ?fix=true corrige los puntajes
+func (c *ScoreController) ReconcileScores(ctx *gin.Context) {
+	fix := false
+	if v := ctx.Query("fix"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid fix parameter"))
+			return
+		}
+		fix = parsed
+	}
+
+	report, apiErr := c.scoreService.ReconcileScores(ctx.Request.Context(), fix)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	dto "prediapp.local/prodes/internal/dto"
+	prodes "prediapp.local/prodes/internal/service"
+	e "prediapp.local/prodes/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SurvivorController struct {
+	survivorService prodes.SurvivorServiceInterface
+}
+
+func NewSurvivorController(survivorService prodes.SurvivorServiceInterface) *SurvivorController {
+	return &SurvivorController{
+		survivorService: survivorService,
+	}
+}
+
+func (c *SurvivorController) CreateLeague(ctx *gin.Context) {
+	groupID, err := strconv.Atoi(ctx.Param("group_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid group ID"))
+		return
+	}
+
+	var request dto.CreateSurvivorLeagueDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid JSON data"))
+		return
+	}
+
+	response, apiErr := c.survivorService.CreateLeague(ctx.Request.Context(), groupID, request)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+func (c *SurvivorController) JoinLeague(ctx *gin.Context) {
+	leagueID, err := strconv.Atoi(ctx.Param("league_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid league ID"))
+		return
+	}
+
+	var request dto.JoinSurvivorLeagueDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid JSON data"))
+		return
+	}
+
+	if apiErr := c.survivorService.JoinLeague(ctx.Request.Context(), leagueID, request); apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"message": "Joined survivor league successfully"})
+}
+
+func (c *SurvivorController) MakePick(ctx *gin.Context) {
+	leagueID, err := strconv.Atoi(ctx.Param("league_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid league ID"))
+		return
+	}
+
+	var request dto.CreateSurvivorPickDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid JSON data"))
+		return
+	}
+
+	response, apiErr := c.survivorService.MakePick(ctx.Request.Context(), leagueID, request)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (c *SurvivorController) ResolveSession(ctx *gin.Context) {
+	sessionID, err := strconv.Atoi(ctx.Param("session_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid session ID"))
+		return
+	}
+
+	if apiErr := c.survivorService.ResolveSession(ctx.Request.Context(), sessionID); apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Survivor picks resolved successfully"})
+}
+
+func (c *SurvivorController) GetStandings(ctx *gin.Context) {
+	groupID, err := strconv.Atoi(ctx.Param("group_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid group ID"))
+		return
+	}
+
+	year, err := queryInt(ctx, "year")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Invalid year"))
+		return
+	}
+
+	response, apiErr := c.survivorService.GetStandings(ctx.Request.Context(), groupID, year)
+	if apiErr != nil {
+		ctx.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
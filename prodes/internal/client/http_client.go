@@ -314,3 +314,19 @@ func (c *HttpClient) GetTopDriversBySession(sessionID int, n int) ([]dto.TopDriv
 
 	return topDrivers, nil
 }
+
+// GetLiveStandings obtiene del microservicio de results las posiciones provisorias de una sesión en curso
+func (c *HttpClient) GetLiveStandings(sessionID int) (dto.LiveStandingsDTO, error) {
+	endpoint := fmt.Sprintf("/results/session/%d/live", sessionID)
+	body, err := c.Get(endpoint)
+	if err != nil {
+		return dto.LiveStandingsDTO{}, fmt.Errorf("error fetching live standings: %w", err)
+	}
+
+	var standings dto.LiveStandingsDTO
+	if err := json.Unmarshal(body, &standings); err != nil {
+		return dto.LiveStandingsDTO{}, fmt.Errorf("error decoding live standings response: %w", err)
+	}
+
+	return standings, nil
+}
@@ -0,0 +1,81 @@
+package prodes
+
+// DTO para crear un equipo fantasy
+type CreateFantasyTeamDTO struct {
+	UserID int    `json:"user_id" binding:"required"`
+	Year   int    `json:"year" binding:"required"`
+	Name   string `json:"name" binding:"required"`
+}
+
+// DTO para armar o modificar la alineación de una carrera (ventana de transferencias)
+type UpdateFantasyLineupDTO struct {
+	UserID          int     `json:"user_id" binding:"required"`
+	SessionID       int     `json:"session_id" binding:"required"`
+	DriverIDs       []int   `json:"driver_ids" binding:"required"`
+	ConstructorName *string `json:"constructor_name"` // Opcional
+}
+
+// DTO para fijar el precio de un piloto o de una escudería
+type SetFantasyPriceDTO struct {
+	Year     int     `json:"year" binding:"required"`
+	DriverID *int    `json:"driver_id"`
+	TeamName *string `json:"team_name"`
+	Price    float64 `json:"price" binding:"required"`
+}
+
+type FantasyDriverPriceDTO struct {
+	DriverID int     `json:"driver_id"`
+	FullName string  `json:"full_name"`
+	TeamName string  `json:"team_name"`
+	Price    float64 `json:"price"`
+}
+
+type FantasyConstructorPriceDTO struct {
+	TeamName string  `json:"team_name"`
+	Price    float64 `json:"price"`
+}
+
+type FantasyPricesDTO struct {
+	Year         int                          `json:"year"`
+	BudgetCap    float64                      `json:"budget_cap"`
+	SquadSize    int                          `json:"squad_size"`
+	Drivers      []FantasyDriverPriceDTO      `json:"drivers"`
+	Constructors []FantasyConstructorPriceDTO `json:"constructors"`
+}
+
+type FantasyLineupDriverDTO struct {
+	DriverID int     `json:"driver_id"`
+	Price    float64 `json:"price"`
+	Points   int     `json:"points"`
+}
+
+type ResponseFantasyLineupDTO struct {
+	ID              int                      `json:"id"`
+	SessionID       int                      `json:"session_id"`
+	Drivers         []FantasyLineupDriverDTO `json:"drivers"`
+	ConstructorName *string                  `json:"constructor_name,omitempty"`
+	ConstructorCost float64                  `json:"constructor_cost"`
+	Cost            float64                  `json:"cost"`
+	Transfers       int                      `json:"transfers"`
+	Points          int                      `json:"points"`
+	Scored          bool                     `json:"scored"`
+}
+
+type ResponseFantasyTeamDTO struct {
+	ID          int                        `json:"id"`
+	UserID      int                        `json:"user_id"`
+	Year        int                        `json:"year"`
+	Name        string                     `json:"name"`
+	TotalPoints int                        `json:"total_points"`
+	Lineups     []ResponseFantasyLineupDTO `json:"lineups,omitempty"`
+}
+
+// FantasyLeaderboardEntryDTO es una fila de la tabla general del modo fantasy
+type FantasyLeaderboardEntryDTO struct {
+	Position    int    `json:"position"`
+	TeamID      int    `json:"team_id"`
+	TeamName    string `json:"team_name"`
+	UserID      int    `json:"user_id"`
+	Username    string `json:"username"`
+	TotalPoints int    `json:"total_points"`
+}
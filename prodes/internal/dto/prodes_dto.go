@@ -15,6 +15,30 @@ type CreateProdeCarreraDTO struct {
 	VSC bool `json:"vsc"`
 	SC  bool `json:"sc"`
 	DNF int  `json:"dnf"`
+	// Ruleset con el que se juega el pronóstico (nil = clásico)
+	RulesetID  *int                     `json:"ruleset_id,omitempty"`
+	Confidence *ConfidenceAllocationDTO `json:"confidence,omitempty"`
+	// Respuestas a los props extendidos (nil = no se modifican)
+	Props []PropAnswerDTO `json:"props,omitempty"`
+}
+
+// ConfidenceAllocationDTO reparte el presupuesto de puntos de confianza entre los picks y props
+type ConfidenceAllocationDTO struct {
+	P1  int `json:"p1"`
+	P2  int `json:"p2"`
+	P3  int `json:"p3"`
+	P4  int `json:"p4"`
+	P5  int `json:"p5"`
+	VSC int `json:"vsc"`
+	SC  int `json:"sc"`
+	DNF int `json:"dnf"`
+}
+
+// PropAnswerDTO es la respuesta de un usuario a un prop extendido (pit stops, bandera roja, etc.)
+type PropAnswerDTO struct {
+	PropID int    `json:"prop_id"`
+	Answer string `json:"answer"`
+	Score  int    `json:"score,omitempty"`
 }
 
 // DTO para crear un pronóstico de sesión que no sea carrera
@@ -49,10 +73,13 @@ type ResponseProdeCarreraDTO struct {
 	P4        int `json:"p4"`         // driver_id
 	P5        int `json:"p5"`         // driver_id
 	// FastestLap int  `json:"fastest_lap"` // driver_id
-	VSC   bool `json:"vsc"`
-	SC    bool `json:"sc"`
-	DNF   int  `json:"dnf"`
-	Score int  `json:"score"`
+	VSC        bool                     `json:"vsc"`
+	SC         bool                     `json:"sc"`
+	DNF        int                      `json:"dnf"`
+	RulesetID  *int                     `json:"ruleset_id,omitempty"`
+	Confidence *ConfidenceAllocationDTO `json:"confidence,omitempty"`
+	Props      []PropAnswerDTO          `json:"props,omitempty"`
+	Score      int                      `json:"score"`
 }
 
 // DTO de respuesta para un pronóstico de sesión
@@ -80,6 +107,11 @@ type UpdateProdeCarreraDTO struct {
 	VSC bool `json:"vsc"`
 	SC  bool `json:"sc"`
 	DNF int  `json:"dnf"`
+	// Ruleset con el que se juega el pronóstico (nil = clásico)
+	RulesetID  *int                     `json:"ruleset_id,omitempty"`
+	Confidence *ConfidenceAllocationDTO `json:"confidence,omitempty"`
+	// Respuestas a los props extendidos (nil = no se modifican)
+	Props []PropAnswerDTO `json:"props,omitempty"`
 }
 
 // DTO para actualizar un pronóstico de sesión que no sea carrera normal
@@ -118,6 +150,7 @@ type SessionDetailsDTO struct {
 	Location         string    `json:"location"`
 	SessionName      string    `json:"session_name"`
 	SessionType      string    `json:"session_type"`
+	Year             int       `json:"year"`
 	VSC              *bool     `json:"vsc"`
 	SC               *bool     `json:"sc"`
 	DNF              *int      `json:"dnf"`
@@ -138,3 +171,53 @@ type TopDriverDTO struct {
 	Position int `json:"position"`
 	DriverID int `json:"driver_id"`
 }
+
+// DTO para crear un ruleset
+type CreateRulesetDTO struct {
+	Name             string `json:"name" binding:"required"`
+	Mode             string `json:"mode" binding:"required"` // "classic" o "confidence"
+	ConfidenceBudget int    `json:"confidence_budget"`
+	MaxPerPick       int    `json:"max_per_pick"`
+}
+
+// DTO de respuesta para un ruleset
+type ResponseRulesetDTO struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	Mode             string `json:"mode"`
+	ConfidenceBudget int    `json:"confidence_budget"`
+	MaxPerPick       int    `json:"max_per_pick"`
+	Active           bool   `json:"active"`
+}
+
+// DTO de respuesta para un prop disponible
+type ResponsePropDTO struct {
+	ID           int       `json:"id"`
+	Code         string    `json:"code"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	AnswerType   string    `json:"answer_type"`
+	Points       int       `json:"points"`
+	BucketBounds []float64 `json:"bucket_bounds,omitempty"` // Límites inferiores de los rangos
+	Buckets      []string  `json:"buckets,omitempty"`       // Rangos que se pueden responder (ej: "20-24", "35+")
+	Active       bool      `json:"active"`
+}
+
+// DTO para crear un prop (admin)
+type CreatePropDTO struct {
+	Code         string    `json:"code" binding:"required"`
+	Name         string    `json:"name" binding:"required"`
+	Description  string    `json:"description"`
+	AnswerType   string    `json:"answer_type" binding:"required"` // boolean, integer, number o driver
+	Points       int       `json:"points"`
+	BucketBounds []float64 `json:"bucket_bounds,omitempty"` // Solo integer/number: se puntúa acertando el rango
+}
+
+// DTO para actualizar un prop (admin). El código y el tipo de respuesta no se cambian porque ya hay respuestas guardadas.
+type UpdatePropDTO struct {
+	Name         *string   `json:"name,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	Points       *int      `json:"points,omitempty"`
+	BucketBounds []float64 `json:"bucket_bounds,omitempty"` // nil no cambia los rangos
+	Active       *bool     `json:"active,omitempty"`
+}
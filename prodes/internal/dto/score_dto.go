@@ -0,0 +1,72 @@
+package prodes
+
+import "time"
+
+// ScoreDiscrepancyDTO es un usuario cuyo puntaje guardado no coincide con la suma de sus prodes
+type ScoreDiscrepancyDTO struct {
+	UserID        int    `json:"user_id"`
+	Username      string `json:"username,omitempty"`
+	Season        *int   `json:"season,omitempty"` // nil = puntaje histórico (users.score)
+	StoredScore   int    `json:"stored_score"`
+	ComputedScore int    `json:"computed_score"`
+	Difference    int    `json:"difference"` // stored - computed
+	Archived      bool   `json:"archived,omitempty"`
+}
+
+// ScoreReconciliationReportDTO resume una corrida de la reconciliación de puntajes
+type ScoreReconciliationReportDTO struct {
+	CheckedAt           time.Time             `json:"checked_at"`
+	UsersChecked        int                   `json:"users_checked"`
+	SeasonsChecked      int                   `json:"seasons_checked"`
+	UserDiscrepancies   int                   `json:"user_discrepancies"`
+	SeasonDiscrepancies int                   `json:"season_discrepancies"`
+	TotalDrift          int                   `json:"total_drift"` // Suma de |difference| en users.score
+	Fixed               bool                  `json:"fixed"`
+	Discrepancies       []ScoreDiscrepancyDTO `json:"discrepancies"`
+}
+
+// ScorePreviewRequestDTO es una clasificación hipotética para simular el puntaje de los prodes de una sesión
+type ScorePreviewRequestDTO struct {
+	TopDrivers []TopDriverDTO `json:"top_drivers" binding:"required"`
+}
+
+// ScorePreviewDTO resume cuánto cambiarían los puntajes de los prodes con esa clasificación, sin guardar nada
+type ScorePreviewDTO struct {
+	SessionID       int `json:"session_id"`
+	ProdesEvaluated int `json:"prodes_evaluated"`
+	ProdesChanged   int `json:"prodes_changed"`
+	UsersAffected   int `json:"users_affected"`
+	TotalDelta      int `json:"total_delta"` // Suma de los cambios de puntaje (con signo)
+}
+
+// LivePositionDTO es la posición provisoria de un piloto que informa el microservicio de results
+type LivePositionDTO struct {
+	Position int       `json:"position"`
+	Driver   DriverDTO `json:"driver"`
+}
+
+// LiveStandingsDTO son las posiciones provisorias de una sesión en curso (microservicio de results)
+type LiveStandingsDTO struct {
+	SessionID int               `json:"session_id"`
+	Finished  bool              `json:"finished"`
+	UpdatedAt time.Time         `json:"updated_at"`
+	Positions []LivePositionDTO `json:"positions"`
+}
+
+// LiveProdeScoreDTO es el puntaje que tendría un prode si la sesión terminara con las posiciones actuales
+type LiveProdeScoreDTO struct {
+	ProdeID     int `json:"prode_id"`
+	UserID      int `json:"user_id"`
+	Score       int `json:"score"`
+	StoredScore int `json:"stored_score"` // Puntaje guardado (0 hasta que se puntúan los resultados oficiales)
+}
+
+// LiveScoresDTO son los puntajes provisorios de los prodes de una sesión en curso. No se guardan.
+type LiveScoresDTO struct {
+	SessionID   int                 `json:"session_id"`
+	Provisional bool                `json:"provisional"` // Siempre true
+	Finished    bool                `json:"finished"`
+	UpdatedAt   time.Time           `json:"updated_at"` // Momento de las posiciones usadas
+	TopDrivers  []TopDriverDTO      `json:"top_drivers"`
+	Scores      []LiveProdeScoreDTO `json:"scores"`
+}
@@ -0,0 +1,52 @@
+package prodes
+
+// DTO para crear una liga survivor dentro de un grupo
+type CreateSurvivorLeagueDTO struct {
+	UserID int `json:"user_id" binding:"required"` // Debe ser el creador del grupo
+	Year   int `json:"year" binding:"required"`
+	TopN   int `json:"top_n"` // Por defecto 10
+}
+
+// DTO para sumarse a una liga survivor
+type JoinSurvivorLeagueDTO struct {
+	UserID int `json:"user_id" binding:"required"`
+}
+
+// DTO para elegir el piloto de una carrera
+type CreateSurvivorPickDTO struct {
+	UserID    int `json:"user_id" binding:"required"`
+	SessionID int `json:"session_id" binding:"required"`
+	DriverID  int `json:"driver_id" binding:"required"`
+}
+
+type ResponseSurvivorLeagueDTO struct {
+	ID           int  `json:"id"`
+	GroupID      int  `json:"group_id"`
+	Year         int  `json:"year"`
+	TopN         int  `json:"top_n"`
+	Finished     bool `json:"finished"`
+	WinnerUserID *int `json:"winner_user_id,omitempty"`
+}
+
+type ResponseSurvivorPickDTO struct {
+	ID        int    `json:"id"`
+	EntryID   int    `json:"entry_id"`
+	SessionID int    `json:"session_id"`
+	DriverID  int    `json:"driver_id"`
+	Result    string `json:"result"`
+}
+
+// SurvivorStandingDTO es una fila de la tabla survivor de un grupo
+type SurvivorStandingDTO struct {
+	UserID              int    `json:"user_id"`
+	Username            string `json:"username"`
+	Status              string `json:"status"`
+	RoundsSurvived      int    `json:"rounds_survived"`
+	UsedDrivers         []int  `json:"used_drivers"`
+	EliminatedSessionID *int   `json:"eliminated_session_id,omitempty"`
+}
+
+type SurvivorStandingsDTO struct {
+	League    ResponseSurvivorLeagueDTO `json:"league"`
+	Standings []SurvivorStandingDTO     `json:"standings"`
+}
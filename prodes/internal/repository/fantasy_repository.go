@@ -0,0 +1,328 @@
+package repository
+
+import (
+	"context"
+
+	"prediapp.local/db/model"
+	e "prediapp.local/prodes/pkg/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type fantasyRepository struct {
+	db *gorm.DB
+}
+
+type FantasyRepository interface {
+	GetDriverPrices(ctx context.Context, year int) ([]*model.FantasyDriverPrice, e.ApiError)
+	GetConstructorPrices(ctx context.Context, year int) ([]*model.FantasyConstructorPrice, e.ApiError)
+	UpsertDriverPrice(ctx context.Context, price *model.FantasyDriverPrice) e.ApiError
+	UpsertConstructorPrice(ctx context.Context, price *model.FantasyConstructorPrice) e.ApiError
+	GetActiveDrivers(ctx context.Context) ([]*model.Driver, e.ApiError)
+	GetDriversByIDs(ctx context.Context, driverIDs []int) ([]*model.Driver, e.ApiError)
+
+	CreateTeam(ctx context.Context, team *model.FantasyTeam) e.ApiError
+	GetTeamByID(ctx context.Context, teamID int) (*model.FantasyTeam, e.ApiError)
+	GetTeamByUserAndYear(ctx context.Context, userID, year int) (*model.FantasyTeam, e.ApiError)
+	GetTeamsByYear(ctx context.Context, year int) ([]*model.FantasyTeam, e.ApiError)
+	GetLeaderboard(ctx context.Context, year int, groupID int) ([]*model.FantasyTeam, e.ApiError)
+
+	GetLineupsByTeam(ctx context.Context, teamID int) ([]*model.FantasyLineup, e.ApiError)
+	GetLineupsBySession(ctx context.Context, sessionID int) ([]*model.FantasyLineup, e.ApiError)
+	GetPreviousLineup(ctx context.Context, teamID, sessionID int) (*model.FantasyLineup, e.ApiError)
+	SaveLineup(ctx context.Context, lineup *model.FantasyLineup) e.ApiError
+
+	GetRaceResults(ctx context.Context, sessionID int) ([]*model.Result, e.ApiError)
+	GetGridPositions(ctx context.Context, sessionID int) (map[int]int, e.ApiError)
+	GetRoundBySession(ctx context.Context, sessionID int) (*model.FantasyRound, e.ApiError)
+	GetSessionsPendingScoring(ctx context.Context) ([]int, e.ApiError)
+	SaveRoundScoring(ctx context.Context, round *model.FantasyRound, lineups []*model.FantasyLineup, teamPoints map[int]int, driverPrices []*model.FantasyDriverPrice, constructorPrices []*model.FantasyConstructorPrice) e.ApiError
+}
+
+func NewFantasyRepository(db *gorm.DB) FantasyRepository {
+	return &fantasyRepository{db: db}
+}
+
+func (r *fantasyRepository) GetDriverPrices(ctx context.Context, year int) ([]*model.FantasyDriverPrice, e.ApiError) {
+	var prices []*model.FantasyDriverPrice
+	if err := r.db.WithContext(ctx).Where("year = ?", year).Find(&prices).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching fantasy driver prices", err)
+	}
+	return prices, nil
+}
+
+func (r *fantasyRepository) GetConstructorPrices(ctx context.Context, year int) ([]*model.FantasyConstructorPrice, e.ApiError) {
+	var prices []*model.FantasyConstructorPrice
+	if err := r.db.WithContext(ctx).Where("year = ?", year).Find(&prices).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching fantasy constructor prices", err)
+	}
+	return prices, nil
+}
+
+func (r *fantasyRepository) UpsertDriverPrice(ctx context.Context, price *model.FantasyDriverPrice) e.ApiError {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "driver_id"}, {Name: "year"}},
+		DoUpdates: clause.AssignmentColumns([]string{"price", "updated_at"}),
+	}).Create(price).Error; err != nil {
+		return e.NewInternalServerApiError("error saving fantasy driver price", err)
+	}
+	return nil
+}
+
+func (r *fantasyRepository) UpsertConstructorPrice(ctx context.Context, price *model.FantasyConstructorPrice) e.ApiError {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "team_name"}, {Name: "year"}},
+		DoUpdates: clause.AssignmentColumns([]string{"price", "updated_at"}),
+	}).Create(price).Error; err != nil {
+		return e.NewInternalServerApiError("error saving fantasy constructor price", err)
+	}
+	return nil
+}
+
+func (r *fantasyRepository) GetActiveDrivers(ctx context.Context) ([]*model.Driver, e.ApiError) {
+	var drivers []*model.Driver
+	if err := r.db.WithContext(ctx).Where("activo = ?", true).Find(&drivers).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching active drivers", err)
+	}
+	return drivers, nil
+}
+
+func (r *fantasyRepository) GetDriversByIDs(ctx context.Context, driverIDs []int) ([]*model.Driver, e.ApiError) {
+	var drivers []*model.Driver
+	if len(driverIDs) == 0 {
+		return drivers, nil
+	}
+	if err := r.db.WithContext(ctx).Where("id IN ?", driverIDs).Find(&drivers).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching drivers", err)
+	}
+	return drivers, nil
+}
+
+func (r *fantasyRepository) CreateTeam(ctx context.Context, team *model.FantasyTeam) e.ApiError {
+	if err := r.db.WithContext(ctx).Create(team).Error; err != nil {
+		return e.NewInternalServerApiError("error creating fantasy team", err)
+	}
+	return nil
+}
+
+func (r *fantasyRepository) GetTeamByID(ctx context.Context, teamID int) (*model.FantasyTeam, e.ApiError) {
+	var team model.FantasyTeam
+	if err := r.db.WithContext(ctx).First(&team, teamID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("fantasy team not found")
+		}
+		return nil, e.NewInternalServerApiError("error finding fantasy team", err)
+	}
+	return &team, nil
+}
+
+func (r *fantasyRepository) GetTeamByUserAndYear(ctx context.Context, userID, year int) (*model.FantasyTeam, e.ApiError) {
+	var team model.FantasyTeam
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND year = ?", userID, year).First(&team).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("fantasy team not found")
+		}
+		return nil, e.NewInternalServerApiError("error finding fantasy team", err)
+	}
+	return &team, nil
+}
+
+func (r *fantasyRepository) GetTeamsByYear(ctx context.Context, year int) ([]*model.FantasyTeam, e.ApiError) {
+	var teams []*model.FantasyTeam
+	if err := r.db.WithContext(ctx).Where("year = ?", year).Find(&teams).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching fantasy teams", err)
+	}
+	return teams, nil
+}
+
+// GetLeaderboard devuelve los equipos de la temporada ordenados por puntos. Si groupID > 0 filtra por los miembros del grupo.
+func (r *fantasyRepository) GetLeaderboard(ctx context.Context, year int, groupID int) ([]*model.FantasyTeam, e.ApiError) {
+	var teams []*model.FantasyTeam
+	query := r.db.WithContext(ctx).Preload("User").Where("year = ?", year)
+	if groupID > 0 {
+		query = query.Where("user_id IN (?)", r.db.Table("group_x_users").Select("user_id").Where("group_id = ? AND group_role IN ?", groupID, []string{"creator", "member"}))
+	}
+	if err := query.Order("total_points DESC").Order("id ASC").Find(&teams).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching fantasy leaderboard", err)
+	}
+	return teams, nil
+}
+
+func (r *fantasyRepository) GetLineupsByTeam(ctx context.Context, teamID int) ([]*model.FantasyLineup, e.ApiError) {
+	var lineups []*model.FantasyLineup
+	if err := r.db.WithContext(ctx).Preload("Drivers").Where("team_id = ?", teamID).Order("id ASC").Find(&lineups).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching fantasy lineups", err)
+	}
+	return lineups, nil
+}
+
+func (r *fantasyRepository) GetLineupsBySession(ctx context.Context, sessionID int) ([]*model.FantasyLineup, e.ApiError) {
+	var lineups []*model.FantasyLineup
+	if err := r.db.WithContext(ctx).Preload("Drivers").Where("session_id = ?", sessionID).Find(&lineups).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching fantasy lineups for session", err)
+	}
+	return lineups, nil
+}
+
+// GetPreviousLineup devuelve la última alineación del equipo para una carrera anterior a la sesión indicada
+func (r *fantasyRepository) GetPreviousLineup(ctx context.Context, teamID, sessionID int) (*model.FantasyLineup, e.ApiError) {
+	var lineup model.FantasyLineup
+	err := r.db.WithContext(ctx).
+		Preload("Drivers").
+		Joins("JOIN sessions ON sessions.id = fantasy_lineups.session_id").
+		Where("fantasy_lineups.team_id = ?", teamID).
+		Where("sessions.date_start < (?)", r.db.Model(&model.Session{}).Select("date_start").Where("id = ?", sessionID)).
+		Order("sessions.date_start DESC").
+		First(&lineup).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("previous fantasy lineup not found")
+		}
+		return nil, e.NewInternalServerApiError("error finding previous fantasy lineup", err)
+	}
+	return &lineup, nil
+}
+
+// SaveLineup crea o reemplaza la alineación (y sus pilotos) de un equipo para una carrera
+func (r *fantasyRepository) SaveLineup(ctx context.Context, lineup *model.FantasyLineup) e.ApiError {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if lineup.ID != 0 {
+			if err := tx.Where("lineup_id = ?", lineup.ID).Delete(&model.FantasyLineupDriver{}).Error; err != nil {
+				return err
+			}
+			for i := range lineup.Drivers {
+				lineup.Drivers[i].ID = 0
+				lineup.Drivers[i].LineupID = lineup.ID
+			}
+		}
+		return tx.Save(lineup).Error
+	})
+	if err != nil {
+		return e.NewInternalServerApiError("error saving fantasy lineup", err)
+	}
+	return nil
+}
+
+func (r *fantasyRepository) GetRaceResults(ctx context.Context, sessionID int) ([]*model.Result, e.ApiError) {
+	var results []*model.Result
+	if err := r.db.WithContext(ctx).Where("session_id = ?", sessionID).Find(&results).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching race results", err)
+	}
+	return results, nil
+}
+
+// GetGridPositions devuelve la posición de largada guardada en los resultados de la propia carrera
+func (r *fantasyRepository) GetGridPositions(ctx context.Context, sessionID int) (map[int]int, e.ApiError) {
+	var rows []struct {
+		DriverID     int
+		GridPosition *int
+	}
+	err := r.db.WithContext(ctx).
+		Model(&model.Result{}).
+		Select("driver_id, grid_position").
+		Where("session_id = ?", sessionID).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, e.NewInternalServerApiError("error fetching grid positions", err)
+	}
+
+	grid := make(map[int]int, len(rows))
+	for _, row := range rows {
+		if row.GridPosition != nil && *row.GridPosition > 0 {
+			grid[row.DriverID] = *row.GridPosition
+		}
+	}
+	return grid, nil
+}
+
+func (r *fantasyRepository) GetRoundBySession(ctx context.Context, sessionID int) (*model.FantasyRound, e.ApiError) {
+	var round model.FantasyRound
+	if err := r.db.WithContext(ctx).Where("session_id = ?", sessionID).First(&round).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("fantasy round not found")
+		}
+		return nil, e.NewInternalServerApiError("error finding fantasy round", err)
+	}
+	return &round, nil
+}
+
+// GetSessionsPendingScoring devuelve las carreras con resultados oficiales que todavía no fueron puntuadas,
+// solo para temporadas con equipos fantasy.
+func (r *fantasyRepository) GetSessionsPendingScoring(ctx context.Context) ([]int, e.ApiError) {
+	var sessionIDs []int
+	err := r.db.WithContext(ctx).
+		Model(&model.Session{}).
+		Where("session_name = ? AND session_type = ?", "Race", "Race").
+		Where("year IN (?)", r.db.Model(&model.FantasyTeam{}).Distinct("year")).
+		Where("id IN (?)", r.db.Model(&model.SessionResultStatus{}).
+			Where("status IN ?", []string{model.ResultStatusOfficial, model.ResultStatusAmended}).
+			Select("session_id")).
+		Where("id NOT IN (?)", r.db.Model(&model.FantasyRound{}).Select("session_id")).
+		Order("date_start ASC").
+		Pluck("id", &sessionIDs).Error
+	if err != nil {
+		return nil, e.NewInternalServerApiError("error fetching sessions pending fantasy scoring", err)
+	}
+	return sessionIDs, nil
+}
+
+// SaveRoundScoring persiste en una transacción la puntuación de una carrera:
+// alineaciones, puntos acumulados por equipo, nuevos precios y la marca de ronda procesada.
+func (r *fantasyRepository) SaveRoundScoring(ctx context.Context, round *model.FantasyRound, lineups []*model.FantasyLineup, teamPoints map[int]int, driverPrices []*model.FantasyDriverPrice, constructorPrices []*model.FantasyConstructorPrice) e.ApiError {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(round).Error; err != nil {
+			return err
+		}
+
+		for _, lineup := range lineups {
+			// Alineaciones arrastradas de la carrera anterior se crean con sus pilotos
+			if lineup.ID == 0 {
+				if err := tx.Create(lineup).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			if err := tx.Model(&model.FantasyLineup{}).Where("id = ?", lineup.ID).Updates(map[string]interface{}{
+				"points": lineup.Points,
+				"scored": lineup.Scored,
+			}).Error; err != nil {
+				return err
+			}
+			for _, d := range lineup.Drivers {
+				if err := tx.Model(&model.FantasyLineupDriver{}).Where("id = ?", d.ID).Update("points", d.Points).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		for teamID, points := range teamPoints {
+			if err := tx.Model(&model.FantasyTeam{}).Where("id = ?", teamID).
+				Update("total_points", gorm.Expr("total_points + ?", points)).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(driverPrices) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "driver_id"}, {Name: "year"}},
+				DoUpdates: clause.AssignmentColumns([]string{"price", "updated_at"}),
+			}).Create(&driverPrices).Error; err != nil {
+				return err
+			}
+		}
+		if len(constructorPrices) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "team_name"}, {Name: "year"}},
+				DoUpdates: clause.AssignmentColumns([]string{"price", "updated_at"}),
+			}).Create(&constructorPrices).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return e.NewInternalServerApiError("error saving fantasy round scoring", err)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	e "prediapp.local/prodes/pkg/utils"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type prodeRepository struct {
@@ -33,7 +34,24 @@ type ProdeRepository interface {
 	GetProdeSessionByUserAndSession(ctx context.Context, userID, sessionID int) (*model.ProdeSession, e.ApiError)
 	GetRaceProdesBySession(ctx context.Context, sessionID int) ([]*model.ProdeCarrera, e.ApiError)
 	GetSessionProdesBySession(ctx context.Context, sessionID int) ([]*model.ProdeSession, e.ApiError)
-	IncrementUserScore(ctx context.Context, userID int, delta int) e.ApiError
+	IncrementUserScore(ctx context.Context, userID int, sessionID int, delta int) e.ApiError
+
+	// Rulesets
+	CreateRuleset(ctx context.Context, ruleset *model.Ruleset) e.ApiError
+	GetRulesetByID(ctx context.Context, rulesetID int) (*model.Ruleset, e.ApiError)
+	GetRulesets(ctx context.Context) ([]*model.Ruleset, e.ApiError)
+
+	// Props extendidos
+	GetActiveProps(ctx context.Context) ([]*model.Prop, e.ApiError)
+	CreateProp(ctx context.Context, prop *model.Prop) e.ApiError
+	GetPropByID(ctx context.Context, propID int) (*model.Prop, e.ApiError)
+	GetPropByCode(ctx context.Context, code string) (*model.Prop, e.ApiError)
+	UpdateProp(ctx context.Context, prop *model.Prop) e.ApiError
+	ReplaceProdeCarreraProps(ctx context.Context, prodeID int, props []*model.ProdeCarreraProp) e.ApiError
+	GetProdeCarreraProps(ctx context.Context, prodeID int) ([]*model.ProdeCarreraProp, e.ApiError)
+	GetProdeCarreraPropsBySession(ctx context.Context, sessionID int) ([]*model.ProdeCarreraProp, e.ApiError)
+	UpdateProdeCarreraPropScore(ctx context.Context, propAnswerID int, score int) e.ApiError
+	GetSessionPropResults(ctx context.Context, sessionID int) ([]*model.SessionPropResult, e.ApiError)
 }
 
 func NewProdeRepository(db *gorm.DB) ProdeRepository {
@@ -296,16 +314,187 @@ func (r *prodeRepository) GetSessionProdesBySession(ctx context.Context, session
 	return prodesSession, nil
 }
 
-func (r *prodeRepository) IncrementUserScore(ctx context.Context, userID int, delta int) e.ApiError {
+// sessionYearExpr asigna una sesión (alias s) a su temporada: el año cargado o, si falta, el de su fecha.
+// Es la misma regla de la migración 0007; todo cálculo de puntaje por temporada debe usarla.
+const sessionYearExpr = "COALESCE(s.year, YEAR(s.date_start))"
+
+// IncrementUserScore suma el delta al puntaje histórico del usuario y al de la temporada de la sesión.
+// Las temporadas ya archivadas no se modifican.
+func (r *prodeRepository) IncrementUserScore(ctx context.Context, userID int, sessionID int, delta int) e.ApiError {
 	if delta == 0 {
 		return nil
 	}
-	if err := r.db.WithContext(ctx).
-		Model(&model.User{}).
-		Where("id = ?", userID).
-		UpdateColumn("score", gorm.Expr("score + ?", delta)).
-		Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.User{}).
+			Where("id = ?", userID).
+			UpdateColumn("score", gorm.Expr("score + ?", delta)).
+			Error; err != nil {
+			return err
+		}
+
+		var year int
+		if err := tx.Table("sessions s").
+			Select(sessionYearExpr).
+			Where("s.id = ?", sessionID).
+			Take(&year).Error; err != nil {
+			return err
+		}
+
+		var archived int64
+		if err := tx.Model(&model.UserSeasonScore{}).
+			Where("year = ? AND archived = ?", year, true).
+			Count(&archived).Error; err != nil {
+			return err
+		}
+		if archived > 0 {
+			return nil
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "year"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"score": gorm.Expr("score + ?", delta),
+			}),
+		}).Create(&model.UserSeasonScore{UserID: userID, Year: year, Score: delta}).Error
+	})
+	if err != nil {
 		return e.NewInternalServerApiError("Error incrementing user score", err)
 	}
 	return nil
 }
+
+func (r *prodeRepository) CreateRuleset(ctx context.Context, ruleset *model.Ruleset) e.ApiError {
+	if err := r.db.WithContext(ctx).Create(ruleset).Error; err != nil {
+		return e.NewInternalServerApiError("error creating ruleset", err)
+	}
+	return nil
+}
+
+func (r *prodeRepository) GetRulesetByID(ctx context.Context, rulesetID int) (*model.Ruleset, e.ApiError) {
+	var ruleset model.Ruleset
+	if err := r.db.WithContext(ctx).First(&ruleset, rulesetID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("ruleset not found")
+		}
+		return nil, e.NewInternalServerApiError("error finding ruleset", err)
+	}
+	return &ruleset, nil
+}
+
+func (r *prodeRepository) GetRulesets(ctx context.Context) ([]*model.Ruleset, e.ApiError) {
+	var rulesets []*model.Ruleset
+	if err := r.db.WithContext(ctx).Where("active = ?", true).Order("id").Find(&rulesets).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching rulesets", err)
+	}
+	return rulesets, nil
+}
+
+func (r *prodeRepository) GetActiveProps(ctx context.Context) ([]*model.Prop, e.ApiError) {
+	var props []*model.Prop
+	if err := r.db.WithContext(ctx).Where("active = ?", true).Order("id").Find(&props).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching props", err)
+	}
+	return props, nil
+}
+
+func (r *prodeRepository) CreateProp(ctx context.Context, prop *model.Prop) e.ApiError {
+	if err := r.db.WithContext(ctx).Create(prop).Error; err != nil {
+		return e.NewInternalServerApiError("error creating prop", err)
+	}
+	return nil
+}
+
+func (r *prodeRepository) GetPropByID(ctx context.Context, propID int) (*model.Prop, e.ApiError) {
+	var prop model.Prop
+	if err := r.db.WithContext(ctx).First(&prop, propID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("prop not found")
+		}
+		return nil, e.NewInternalServerApiError("error finding prop", err)
+	}
+	return &prop, nil
+}
+
+// GetPropByCode devuelve nil, nil si no hay un prop con ese código
+func (r *prodeRepository) GetPropByCode(ctx context.Context, code string) (*model.Prop, e.ApiError) {
+	var prop model.Prop
+	if err := r.db.WithContext(ctx).Where("code = ?", code).First(&prop).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, e.NewInternalServerApiError("error finding prop by code", err)
+	}
+	return &prop, nil
+}
+
+func (r *prodeRepository) UpdateProp(ctx context.Context, prop *model.Prop) e.ApiError {
+	if err := r.db.WithContext(ctx).Model(&model.Prop{}).Where("id = ?", prop.ID).Updates(map[string]interface{}{
+		"name":        prop.Name,
+		"description": prop.Description,
+		"points":      prop.Points,
+		"buckets":     prop.Buckets,
+		"active":      prop.Active,
+	}).Error; err != nil {
+		return e.NewInternalServerApiError("error updating prop", err)
+	}
+	return nil
+}
+
+// ReplaceProdeCarreraProps reemplaza todas las respuestas a props de un prode en una transacción
+func (r *prodeRepository) ReplaceProdeCarreraProps(ctx context.Context, prodeID int, props []*model.ProdeCarreraProp) e.ApiError {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("prode_carrera_id = ?", prodeID).Delete(&model.ProdeCarreraProp{}).Error; err != nil {
+			return err
+		}
+		if len(props) == 0 {
+			return nil
+		}
+		for _, p := range props {
+			p.ProdeCarreraID = prodeID
+		}
+		return tx.Create(&props).Error
+	})
+	if err != nil {
+		return e.NewInternalServerApiError("error saving prode carrera props", err)
+	}
+	return nil
+}
+
+func (r *prodeRepository) GetProdeCarreraProps(ctx context.Context, prodeID int) ([]*model.ProdeCarreraProp, e.ApiError) {
+	var props []*model.ProdeCarreraProp
+	if err := r.db.WithContext(ctx).Where("prode_carrera_id = ?", prodeID).Order("prop_id").Find(&props).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching prode carrera props", err)
+	}
+	return props, nil
+}
+
+// GetProdeCarreraPropsBySession trae las respuestas a props de todos los prodes de una sesión
+func (r *prodeRepository) GetProdeCarreraPropsBySession(ctx context.Context, sessionID int) ([]*model.ProdeCarreraProp, e.ApiError) {
+	var props []*model.ProdeCarreraProp
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN prode_carreras ON prode_carreras.id = prode_carrera_props.prode_carrera_id").
+		Where("prode_carreras.session_id = ? AND prode_carreras.deleted_at IS NULL", sessionID).
+		Find(&props).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching prode carrera props by session", err)
+	}
+	return props, nil
+}
+
+func (r *prodeRepository) UpdateProdeCarreraPropScore(ctx context.Context, propAnswerID int, score int) e.ApiError {
+	if err := r.db.WithContext(ctx).
+		Model(&model.ProdeCarreraProp{}).
+		Where("id = ?", propAnswerID).
+		Update("score", score).Error; err != nil {
+		return e.NewInternalServerApiError("error updating prode carrera prop score", err)
+	}
+	return nil
+}
+
+// GetSessionPropResults obtiene los valores reales de los props (resueltos por el servicio de results)
+func (r *prodeRepository) GetSessionPropResults(ctx context.Context, sessionID int) ([]*model.SessionPropResult, e.ApiError) {
+	var propResults []*model.SessionPropResult
+	if err := r.db.WithContext(ctx).Where("session_id = ?", sessionID).Find(&propResults).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching session prop results", err)
+	}
+	return propResults, nil
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+
+	"prediapp.local/db/model"
+	e "prediapp.local/prodes/pkg/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserScoreTotal compara el puntaje guardado de un usuario con la suma de sus prodes
+type UserScoreTotal struct {
+	UserID        int
+	Username      string
+	StoredScore   int
+	ComputedScore int
+}
+
+// SeasonScoreTotal compara el puntaje de temporada guardado con la suma de los prodes de ese año
+type SeasonScoreTotal struct {
+	UserID        int
+	Year          int
+	StoredScore   int
+	ComputedScore int
+	Archived      bool
+}
+
+type scoreRepository struct {
+	db *gorm.DB
+}
+
+type ScoreRepository interface {
+	GetUserScoreTotals(ctx context.Context) ([]UserScoreTotal, e.ApiError)
+	GetSeasonScoreTotals(ctx context.Context) ([]SeasonScoreTotal, e.ApiError)
+	FixScores(ctx context.Context, users []UserScoreTotal, seasons []SeasonScoreTotal) e.ApiError
+}
+
+func NewScoreRepository(db *gorm.DB) ScoreRepository {
+	return &scoreRepository{db: db}
+}
+
+// GetUserScoreTotals recalcula el total de cada usuario a partir de prode_carreras y prode_sessions
+func (r *scoreRepository) GetUserScoreTotals(ctx context.Context) ([]UserScoreTotal, e.ApiError) {
+	var totals []UserScoreTotal
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT u.id AS user_id, u.username, u.score AS stored_score,
+			COALESCE((SELECT SUM(pc.score) FROM prode_carreras pc WHERE pc.user_id = u.id AND pc.deleted_at IS NULL), 0) +
+			COALESCE((SELECT SUM(ps.score) FROM prode_sessions ps WHERE ps.user_id = u.id AND ps.deleted_at IS NULL), 0) AS computed_score
+		FROM users u
+		WHERE u.deleted_at IS NULL
+		ORDER BY u.id`).Scan(&totals).Error
+	if err != nil {
+		return nil, e.NewInternalServerApiError("error computing user score totals", err)
+	}
+	return totals, nil
+}
+
+// GetSeasonScoreTotals recalcula el puntaje por usuario y temporada y lo cruza con user_season_scores.
+// Incluye las temporadas que existen de un solo lado. Ignora usuarios y sesiones borrados y asigna
+// cada sesión a su temporada con sessionYearExpr, igual que IncrementUserScore.
+func (r *scoreRepository) GetSeasonScoreTotals(ctx context.Context) ([]SeasonScoreTotal, e.ApiError) {
+	var computed []SeasonScoreTotal
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT t.user_id, t.year, SUM(t.score) AS computed_score
+		FROM (
+			SELECT pc.user_id, ` + sessionYearExpr + ` AS year, pc.score FROM prode_carreras pc
+			JOIN sessions s ON s.id = pc.session_id
+			WHERE pc.deleted_at IS NULL AND s.deleted_at IS NULL
+			UNION ALL
+			SELECT ps.user_id, ` + sessionYearExpr + ` AS year, ps.score FROM prode_sessions ps
+			JOIN sessions s ON s.id = ps.session_id
+			WHERE ps.deleted_at IS NULL AND s.deleted_at IS NULL
+		) t
+		JOIN users u ON u.id = t.user_id
+		WHERE u.deleted_at IS NULL
+		GROUP BY t.user_id, t.year`).Scan(&computed).Error
+	if err != nil {
+		return nil, e.NewInternalServerApiError("error computing season score totals", err)
+	}
+
+	var stored []*model.UserSeasonScore
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN users ON users.id = user_season_scores.user_id").
+		Where("users.deleted_at IS NULL").
+		Find(&stored).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching season scores", err)
+	}
+
+	type key struct{ userID, year int }
+	totals := make(map[key]*SeasonScoreTotal)
+	var order []key
+	for _, c := range computed {
+		k := key{c.UserID, c.Year}
+		total := c
+		totals[k] = &total
+		order = append(order, k)
+	}
+	for _, s := range stored {
+		k := key{s.UserID, s.Year}
+		total, ok := totals[k]
+		if !ok {
+			total = &SeasonScoreTotal{UserID: s.UserID, Year: s.Year}
+			totals[k] = total
+			order = append(order, k)
+		}
+		total.StoredScore = s.Score
+		total.Archived = s.Archived
+	}
+
+	result := make([]SeasonScoreTotal, 0, len(order))
+	for _, k := range order {
+		result = append(result, *totals[k])
+	}
+	return result, nil
+}
+
+// FixScores sobrescribe los puntajes guardados con los recalculados. Las temporadas archivadas no se tocan.
+func (r *scoreRepository) FixScores(ctx context.Context, users []UserScoreTotal, seasons []SeasonScoreTotal) e.ApiError {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, u := range users {
+			if err := tx.Model(&model.User{}).Where("id = ?", u.UserID).
+				UpdateColumn("score", u.ComputedScore).Error; err != nil {
+				return err
+			}
+		}
+		for _, s := range seasons {
+			if s.Archived {
+				continue
+			}
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}, {Name: "year"}},
+				DoUpdates: clause.AssignmentColumns([]string{"score", "updated_at"}),
+			}).Create(&model.UserSeasonScore{UserID: s.UserID, Year: s.Year, Score: s.ComputedScore}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return e.NewInternalServerApiError("error fixing user scores", err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+
+	"prediapp.local/db/model"
+	e "prediapp.local/prodes/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type survivorRepository struct {
+	db *gorm.DB
+}
+
+type SurvivorRepository interface {
+	CreateLeague(ctx context.Context, league *model.SurvivorLeague) e.ApiError
+	GetLeagueByID(ctx context.Context, leagueID int) (*model.SurvivorLeague, e.ApiError)
+	GetLeagueByGroupAndYear(ctx context.Context, groupID, year int) (*model.SurvivorLeague, e.ApiError)
+	GetActiveLeaguesByYear(ctx context.Context, year int) ([]*model.SurvivorLeague, e.ApiError)
+	GetUserRoleInGroup(ctx context.Context, groupID, userID int) (string, e.ApiError)
+
+	CreateEntry(ctx context.Context, entry *model.SurvivorEntry) e.ApiError
+	GetEntryByLeagueAndUser(ctx context.Context, leagueID, userID int) (*model.SurvivorEntry, e.ApiError)
+	GetEntriesByLeague(ctx context.Context, leagueID int) ([]*model.SurvivorEntry, e.ApiError)
+
+	SavePick(ctx context.Context, pick *model.SurvivorPick) e.ApiError
+	GetPicksByEntry(ctx context.Context, entryID int) ([]*model.SurvivorPick, e.ApiError)
+	GetPicksByLeagueAndSession(ctx context.Context, leagueID, sessionID int) ([]*model.SurvivorPick, e.ApiError)
+	GetPicksByLeague(ctx context.Context, leagueID int) ([]*model.SurvivorPick, e.ApiError)
+	GetSessionsPendingResolution(ctx context.Context) ([]int, e.ApiError)
+
+	SaveRoundResolution(ctx context.Context, league *model.SurvivorLeague, entries []*model.SurvivorEntry, picks []*model.SurvivorPick) e.ApiError
+}
+
+func NewSurvivorRepository(db *gorm.DB) SurvivorRepository {
+	return &survivorRepository{db: db}
+}
+
+func (r *survivorRepository) CreateLeague(ctx context.Context, league *model.SurvivorLeague) e.ApiError {
+	if err := r.db.WithContext(ctx).Create(league).Error; err != nil {
+		return e.NewInternalServerApiError("error creating survivor league", err)
+	}
+	return nil
+}
+
+func (r *survivorRepository) GetLeagueByID(ctx context.Context, leagueID int) (*model.SurvivorLeague, e.ApiError) {
+	var league model.SurvivorLeague
+	if err := r.db.WithContext(ctx).First(&league, leagueID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("survivor league not found")
+		}
+		return nil, e.NewInternalServerApiError("error finding survivor league", err)
+	}
+	return &league, nil
+}
+
+func (r *survivorRepository) GetLeagueByGroupAndYear(ctx context.Context, groupID, year int) (*model.SurvivorLeague, e.ApiError) {
+	var league model.SurvivorLeague
+	if err := r.db.WithContext(ctx).Where("group_id = ? AND year = ?", groupID, year).First(&league).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("survivor league not found")
+		}
+		return nil, e.NewInternalServerApiError("error finding survivor league", err)
+	}
+	return &league, nil
+}
+
+func (r *survivorRepository) GetActiveLeaguesByYear(ctx context.Context, year int) ([]*model.SurvivorLeague, e.ApiError) {
+	var leagues []*model.SurvivorLeague
+	if err := r.db.WithContext(ctx).Where("year = ? AND finished = ?", year, false).Find(&leagues).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching survivor leagues", err)
+	}
+	return leagues, nil
+}
+
+// GetUserRoleInGroup devuelve el rol del usuario en el grupo ("" si no pertenece)
+func (r *survivorRepository) GetUserRoleInGroup(ctx context.Context, groupID, userID int) (string, e.ApiError) {
+	var role string
+	if err := r.db.WithContext(ctx).
+		Table("group_x_users").
+		Select("group_role").
+		Where("group_id = ? AND user_id = ?", groupID, userID).
+		Scan(&role).Error; err != nil {
+		return "", e.NewInternalServerApiError("error getting user role in group", err)
+	}
+	return role, nil
+}
+
+func (r *survivorRepository) CreateEntry(ctx context.Context, entry *model.SurvivorEntry) e.ApiError {
+	if err := r.db.WithContext(ctx).Create(entry).Error; err != nil {
+		return e.NewInternalServerApiError("error creating survivor entry", err)
+	}
+	return nil
+}
+
+func (r *survivorRepository) GetEntryByLeagueAndUser(ctx context.Context, leagueID, userID int) (*model.SurvivorEntry, e.ApiError) {
+	var entry model.SurvivorEntry
+	if err := r.db.WithContext(ctx).Where("league_id = ? AND user_id = ?", leagueID, userID).First(&entry).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("survivor entry not found")
+		}
+		return nil, e.NewInternalServerApiError("error finding survivor entry", err)
+	}
+	return &entry, nil
+}
+
+func (r *survivorRepository) GetEntriesByLeague(ctx context.Context, leagueID int) ([]*model.SurvivorEntry, e.ApiError) {
+	var entries []*model.SurvivorEntry
+	if err := r.db.WithContext(ctx).Preload("User").Where("league_id = ?", leagueID).Find(&entries).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching survivor entries", err)
+	}
+	return entries, nil
+}
+
+// SavePick crea o actualiza el pick de una entry para una sesión
+func (r *survivorRepository) SavePick(ctx context.Context, pick *model.SurvivorPick) e.ApiError {
+	if err := r.db.WithContext(ctx).Save(pick).Error; err != nil {
+		return e.NewInternalServerApiError("error saving survivor pick", err)
+	}
+	return nil
+}
+
+func (r *survivorRepository) GetPicksByEntry(ctx context.Context, entryID int) ([]*model.SurvivorPick, e.ApiError) {
+	var picks []*model.SurvivorPick
+	if err := r.db.WithContext(ctx).Where("entry_id = ?", entryID).Find(&picks).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching survivor picks", err)
+	}
+	return picks, nil
+}
+
+func (r *survivorRepository) GetPicksByLeagueAndSession(ctx context.Context, leagueID, sessionID int) ([]*model.SurvivorPick, e.ApiError) {
+	var picks []*model.SurvivorPick
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN survivor_entries ON survivor_entries.id = survivor_picks.entry_id").
+		Where("survivor_entries.league_id = ? AND survivor_picks.session_id = ?", leagueID, sessionID).
+		Find(&picks).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching survivor picks for session", err)
+	}
+	return picks, nil
+}
+
+func (r *survivorRepository) GetPicksByLeague(ctx context.Context, leagueID int) ([]*model.SurvivorPick, e.ApiError) {
+	var picks []*model.SurvivorPick
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN survivor_entries ON survivor_entries.id = survivor_picks.entry_id").
+		Where("survivor_entries.league_id = ?", leagueID).
+		Find(&picks).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching survivor picks for league", err)
+	}
+	return picks, nil
+}
+
+// GetSessionsPendingResolution devuelve las sesiones con picks pendientes cuyos resultados ya son oficiales.
+// Solo cuentan los picks de participantes vivos en ligas sin terminar: el resto nunca se resuelve.
+func (r *survivorRepository) GetSessionsPendingResolution(ctx context.Context) ([]int, e.ApiError) {
+	var sessionIDs []int
+	if err := r.db.WithContext(ctx).
+		Model(&model.SurvivorPick{}).
+		Distinct("survivor_picks.session_id").
+		Joins("JOIN survivor_entries ON survivor_entries.id = survivor_picks.entry_id").
+		Joins("JOIN survivor_leagues ON survivor_leagues.id = survivor_entries.league_id").
+		Joins("JOIN session_result_statuses ON session_result_statuses.session_id = survivor_picks.session_id").
+		Where("survivor_picks.result = ?", model.SurvivorPickPending).
+		Where("survivor_entries.status = ? AND survivor_leagues.finished = ?", model.SurvivorStatusAlive, false).
+		Where("session_result_statuses.status IN ?", []string{model.ResultStatusOfficial, model.ResultStatusAmended}).
+		Pluck("survivor_picks.session_id", &sessionIDs).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error fetching sessions pending survivor resolution", err)
+	}
+	return sessionIDs, nil
+}
+
+// SaveRoundResolution persiste en una transacción el resultado de una fecha: picks, entries y liga
+func (r *survivorRepository) SaveRoundResolution(ctx context.Context, league *model.SurvivorLeague, entries []*model.SurvivorEntry, picks []*model.SurvivorPick) e.ApiError {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, pick := range picks {
+			if err := tx.Model(&model.SurvivorPick{}).Where("id = ?", pick.ID).Update("result", pick.Result).Error; err != nil {
+				return err
+			}
+		}
+		for _, entry := range entries {
+			if err := tx.Model(&model.SurvivorEntry{}).Where("id = ?", entry.ID).Updates(map[string]interface{}{
+				"status":                entry.Status,
+				"eliminated_session_id": entry.EliminatedSessionID,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&model.SurvivorLeague{}).Where("id = ?", league.ID).Updates(map[string]interface{}{
+			"finished":       league.Finished,
+			"winner_user_id": league.WinnerUserID,
+		}).Error
+	})
+	if err != nil {
+		return e.NewInternalServerApiError("error saving survivor round resolution", err)
+	}
+	return nil
+}
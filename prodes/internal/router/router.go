@@ -6,7 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func MapUrls(engine *gin.Engine, prodeController *prodes.ProdeController) {
+func MapUrls(engine *gin.Engine, prodeController *prodes.ProdeController, survivorController *prodes.SurvivorController, fantasyController *prodes.FantasyController, scoreController *prodes.ScoreController) {
 	// Rutas relacionadas con prodes de carrera
 	engine.POST("/prodes/carrera", prodeController.CreateProdeCarrera)
 	engine.PUT("/prodes/carrera/:prode_id", prodeController.UpdateProdeCarrera)
@@ -14,6 +14,7 @@ func MapUrls(engine *gin.Engine, prodeController *prodes.ProdeController) {
 	engine.GET("/prodes/carrera/session/:session_id", prodeController.GetRaceProdesBySession)
 	engine.PUT("/prodes/carrera/user/:user_id/session/:session_id", prodeController.UpdateRaceProdeForUserBySessionId)
 	engine.POST("/prodes/carrera/:session_id/score", prodeController.UpdateScoresForRace)
+	engine.POST("/prodes/carrera/:session_id/score/preview", prodeController.PreviewScoresForRace)
 
 	// Rutas relacionadas con prodes de sesión
 	engine.POST("/prodes/session", prodeController.CreateProdeSession)
@@ -21,6 +22,40 @@ func MapUrls(engine *gin.Engine, prodeController *prodes.ProdeController) {
 	// engine.GET("/prodes/session/user/:user_id/session/:session_id", prodeController.GetSessionProdeByUserAndSession)
 	engine.GET("/prodes/session/:session_id", prodeController.GetSessionProdesBySession)
 	engine.POST("/prodes/session/:session_id/score", prodeController.UpdateScoresForSession)
+	engine.POST("/prodes/session/:session_id/score/preview", prodeController.PreviewScoresForSession)
+
+	// Puntajes provisorios con las posiciones en vivo (no se guardan)
+	engine.GET("/prodes/live/:session_id", prodeController.GetLiveScores)
+
+	// Rutas relacionadas con rulesets (modos de juego)
+	engine.POST("/prodes/rulesets", prodeController.CreateRuleset)
+	engine.GET("/prodes/rulesets", prodeController.GetRulesets)
+
+	// Props extendidos disponibles para los prodes de carrera
+	engine.GET("/prodes/props", prodeController.GetProps)
+	engine.POST("/prodes/props", prodeController.CreateProp)            // Crear un prop (admin)
+	engine.PUT("/prodes/props/:prop_id", prodeController.UpdateProp)    // Editar nombre, puntos, rangos o estado (admin)
+	engine.DELETE("/prodes/props/:prop_id", prodeController.DeleteProp) // Desactivar un prop (admin)
+
+	// Rutas relacionadas con el modo survivor de los grupos
+	engine.POST("/prodes/survivor/groups/:group_id", survivorController.CreateLeague)
+	engine.GET("/prodes/survivor/groups/:group_id/standings", survivorController.GetStandings)
+	engine.POST("/prodes/survivor/leagues/:league_id/join", survivorController.JoinLeague)
+	engine.POST("/prodes/survivor/leagues/:league_id/picks", survivorController.MakePick)
+	engine.POST("/prodes/survivor/session/:session_id/resolve", survivorController.ResolveSession)
+
+	// Rutas relacionadas con el modo fantasy
+	engine.GET("/prodes/fantasy/prices", fantasyController.GetPrices)
+	engine.PUT("/prodes/fantasy/prices", fantasyController.SetPrice)
+	engine.POST("/prodes/fantasy/teams", fantasyController.CreateTeam)
+	engine.GET("/prodes/fantasy/teams/:team_id", fantasyController.GetTeam)
+	engine.PUT("/prodes/fantasy/teams/:team_id/lineup", fantasyController.UpdateLineup)
+	engine.POST("/prodes/fantasy/session/:session_id/score", fantasyController.ScoreSession)
+	engine.GET("/prodes/fantasy/leaderboard", fantasyController.GetLeaderboard)
+
+	// Reconciliación de puntajes (admin)
+	engine.GET("/prodes/admin/scores/reconciliation", scoreController.GetReconciliationSummary)
+	engine.POST("/prodes/admin/scores/reconciliation", scoreController.ReconcileScores)
 
 	// Rutas para eliminar prodes
 	engine.DELETE("/prodes/:id", prodeController.DeleteProdeById)
@@ -0,0 +1,627 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	model "prediapp.local/db/model"
+	client "prediapp.local/prodes/internal/client"
+	prodes "prediapp.local/prodes/internal/dto"
+	repository "prediapp.local/prodes/internal/repository"
+	e "prediapp.local/prodes/pkg/utils"
+)
+
+// Reglas del modo fantasy
+const (
+	fantasySquadSize          = 5     // Pilotos por alineación
+	fantasyBudgetCap          = 100.0 // Tope de presupuesto (millones)
+	fantasyFreeTransfers      = 2     // Cambios gratis por ventana de transferencias
+	fantasyTransferPenalty    = 4     // Puntos que se descuentan por cada cambio extra
+	fantasyDefaultDriverPrice = 10.0
+	fantasyDefaultTeamPrice   = 15.0
+	fantasyMinPrice           = 4.5
+	fantasyMaxPrice           = 35.0
+	fantasyFastestLapPoints   = 5
+	fantasyDNFPoints          = -5
+	fantasyMaxPositionsDelta  = 10 // Tope de puntos por posiciones ganadas/perdidas
+)
+
+// Puntos por posición final (P1..P10)
+var fantasyFinishPoints = []int{25, 18, 15, 12, 10, 8, 6, 4, 2, 1}
+
+type fantasyService struct {
+	fantasyRepo   repository.FantasyRepository
+	sessionClient *client.HttpClient
+}
+
+type FantasyServiceInterface interface {
+	GetPrices(ctx context.Context, year int) (prodes.FantasyPricesDTO, e.ApiError)
+	SetPrice(ctx context.Context, request prodes.SetFantasyPriceDTO) e.ApiError
+	CreateTeam(ctx context.Context, request prodes.CreateFantasyTeamDTO) (prodes.ResponseFantasyTeamDTO, e.ApiError)
+	GetTeam(ctx context.Context, teamID int) (prodes.ResponseFantasyTeamDTO, e.ApiError)
+	UpdateLineup(ctx context.Context, teamID int, request prodes.UpdateFantasyLineupDTO) (prodes.ResponseFantasyLineupDTO, e.ApiError)
+	ScoreSession(ctx context.Context, sessionID int) e.ApiError
+	GetLeaderboard(ctx context.Context, year, groupID int) ([]prodes.FantasyLeaderboardEntryDTO, e.ApiError)
+	StartScoringJob(ctx context.Context, interval time.Duration)
+}
+
+// NewFantasyService crea el servicio del modo fantasy
+func NewFantasyService(fantasyRepo repository.FantasyRepository, sessionClient *client.HttpClient) FantasyServiceInterface {
+	return &fantasyService{
+		fantasyRepo:   fantasyRepo,
+		sessionClient: sessionClient,
+	}
+}
+
+func (s *fantasyService) GetPrices(ctx context.Context, year int) (prodes.FantasyPricesDTO, e.ApiError) {
+	if year == 0 {
+		year = time.Now().Year()
+	}
+
+	drivers, apiErr := s.fantasyRepo.GetActiveDrivers(ctx)
+	if apiErr != nil {
+		return prodes.FantasyPricesDTO{}, apiErr
+	}
+	driverPrices, constructorPrices, apiErr := s.loadPrices(ctx, year)
+	if apiErr != nil {
+		return prodes.FantasyPricesDTO{}, apiErr
+	}
+
+	response := prodes.FantasyPricesDTO{
+		Year:         year,
+		BudgetCap:    fantasyBudgetCap,
+		SquadSize:    fantasySquadSize,
+		Drivers:      []prodes.FantasyDriverPriceDTO{},
+		Constructors: []prodes.FantasyConstructorPriceDTO{},
+	}
+	teams := make(map[string]bool)
+	for _, d := range drivers {
+		response.Drivers = append(response.Drivers, prodes.FantasyDriverPriceDTO{
+			DriverID: d.ID,
+			FullName: d.FullName,
+			TeamName: d.TeamName,
+			Price:    driverPrice(driverPrices, d.ID),
+		})
+		if d.TeamName != "" && !teams[d.TeamName] {
+			teams[d.TeamName] = true
+			response.Constructors = append(response.Constructors, prodes.FantasyConstructorPriceDTO{
+				TeamName: d.TeamName,
+				Price:    constructorPrice(constructorPrices, d.TeamName),
+			})
+		}
+	}
+
+	sort.Slice(response.Drivers, func(i, j int) bool { return response.Drivers[i].Price > response.Drivers[j].Price })
+	sort.Slice(response.Constructors, func(i, j int) bool { return response.Constructors[i].Price > response.Constructors[j].Price })
+
+	return response, nil
+}
+
+func (s *fantasyService) SetPrice(ctx context.Context, request prodes.SetFantasyPriceDTO) e.ApiError {
+	if request.Price < fantasyMinPrice || request.Price > fantasyMaxPrice {
+		return e.NewBadRequestApiError("price out of range")
+	}
+	if (request.DriverID == nil) == (request.TeamName == nil) {
+		return e.NewBadRequestApiError("either driver_id or team_name must be provided")
+	}
+
+	if request.DriverID != nil {
+		return s.fantasyRepo.UpsertDriverPrice(ctx, &model.FantasyDriverPrice{
+			DriverID: *request.DriverID,
+			Year:     request.Year,
+			Price:    request.Price,
+		})
+	}
+	return s.fantasyRepo.UpsertConstructorPrice(ctx, &model.FantasyConstructorPrice{
+		TeamName: *request.TeamName,
+		Year:     request.Year,
+		Price:    request.Price,
+	})
+}
+
+func (s *fantasyService) CreateTeam(ctx context.Context, request prodes.CreateFantasyTeamDTO) (prodes.ResponseFantasyTeamDTO, e.ApiError) {
+	// Un equipo por usuario y temporada
+	if _, apiErr := s.fantasyRepo.GetTeamByUserAndYear(ctx, request.UserID, request.Year); apiErr == nil {
+		return prodes.ResponseFantasyTeamDTO{}, e.NewConflictApiError("User already has a fantasy team for that year")
+	} else if apiErr.Status() != http.StatusNotFound {
+		return prodes.ResponseFantasyTeamDTO{}, apiErr
+	}
+
+	team := &model.FantasyTeam{
+		UserID: request.UserID,
+		Year:   request.Year,
+		Name:   request.Name,
+	}
+	if apiErr := s.fantasyRepo.CreateTeam(ctx, team); apiErr != nil {
+		return prodes.ResponseFantasyTeamDTO{}, apiErr
+	}
+
+	return toResponseFantasyTeamDTO(team, nil), nil
+}
+
+func (s *fantasyService) GetTeam(ctx context.Context, teamID int) (prodes.ResponseFantasyTeamDTO, e.ApiError) {
+	team, apiErr := s.fantasyRepo.GetTeamByID(ctx, teamID)
+	if apiErr != nil {
+		return prodes.ResponseFantasyTeamDTO{}, apiErr
+	}
+	lineups, apiErr := s.fantasyRepo.GetLineupsByTeam(ctx, teamID)
+	if apiErr != nil {
+		return prodes.ResponseFantasyTeamDTO{}, apiErr
+	}
+	return toResponseFantasyTeamDTO(team, lineups), nil
+}
+
+// UpdateLineup arma o modifica la alineación de una carrera. La ventana de transferencias
+// se cierra con el DateStart de la sesión.
+func (s *fantasyService) UpdateLineup(ctx context.Context, teamID int, request prodes.UpdateFantasyLineupDTO) (prodes.ResponseFantasyLineupDTO, e.ApiError) {
+	// 1. Validar el equipo y su dueño
+	team, apiErr := s.fantasyRepo.GetTeamByID(ctx, teamID)
+	if apiErr != nil {
+		return prodes.ResponseFantasyLineupDTO{}, apiErr
+	}
+	if team.UserID != request.UserID {
+		return prodes.ResponseFantasyLineupDTO{}, e.NewForbiddenApiError("The fantasy team does not belong to the user")
+	}
+
+	// 2. Validar la sesión y que la ventana siga abierta
+	sessionDetails, err := s.sessionClient.GetSessionByID(request.SessionID)
+	if err != nil {
+		return prodes.ResponseFantasyLineupDTO{}, e.NewInternalServerApiError("Error fetching session details", err)
+	}
+	if !isRaceSession(sessionDetails.SessionName, sessionDetails.SessionType) {
+		return prodes.ResponseFantasyLineupDTO{}, e.NewBadRequestApiError("Fantasy lineups are only allowed for race sessions")
+	}
+	if sessionDetails.Year != 0 && sessionDetails.Year != team.Year {
+		return prodes.ResponseFantasyLineupDTO{}, e.NewBadRequestApiError("Session does not belong to the fantasy team season")
+	}
+	if time.Now().After(sessionDetails.DateStart) {
+		return prodes.ResponseFantasyLineupDTO{}, e.NewForbiddenApiError("La ventana de transferencias está cerrada, la carrera ya ha comenzado")
+	}
+
+	// 3. Validar la composición del equipo
+	if len(request.DriverIDs) != fantasySquadSize {
+		return prodes.ResponseFantasyLineupDTO{}, e.NewBadRequestApiError("A fantasy lineup must have exactly 5 drivers")
+	}
+	drivers, apiErr := s.fantasyRepo.GetActiveDrivers(ctx)
+	if apiErr != nil {
+		return prodes.ResponseFantasyLineupDTO{}, apiErr
+	}
+	activeDrivers := make(map[int]*model.Driver, len(drivers))
+	teamNames := make(map[string]bool)
+	for _, d := range drivers {
+		activeDrivers[d.ID] = d
+		teamNames[d.TeamName] = true
+	}
+	seen := make(map[int]bool, len(request.DriverIDs))
+	for _, id := range request.DriverIDs {
+		if _, ok := activeDrivers[id]; !ok {
+			return prodes.ResponseFantasyLineupDTO{}, e.NewBadRequestApiError("Invalid driver in fantasy lineup")
+		}
+		if seen[id] {
+			return prodes.ResponseFantasyLineupDTO{}, e.NewBadRequestApiError("Drivers in a fantasy lineup must be distinct")
+		}
+		seen[id] = true
+	}
+	if request.ConstructorName != nil && !teamNames[*request.ConstructorName] {
+		return prodes.ResponseFantasyLineupDTO{}, e.NewBadRequestApiError("Invalid constructor in fantasy lineup")
+	}
+
+	// 4. Calcular el costo con los precios vigentes y validar el tope
+	driverPrices, constructorPrices, apiErr := s.loadPrices(ctx, team.Year)
+	if apiErr != nil {
+		return prodes.ResponseFantasyLineupDTO{}, apiErr
+	}
+	lineupDrivers := make([]model.FantasyLineupDriver, 0, len(request.DriverIDs))
+	cost := 0.0
+	for _, id := range request.DriverIDs {
+		price := driverPrice(driverPrices, id)
+		cost += price
+		lineupDrivers = append(lineupDrivers, model.FantasyLineupDriver{DriverID: id, Price: price})
+	}
+	constructorCost := 0.0
+	if request.ConstructorName != nil {
+		constructorCost = constructorPrice(constructorPrices, *request.ConstructorName)
+		cost += constructorCost
+	}
+	if roundPrice(cost) > fantasyBudgetCap {
+		return prodes.ResponseFantasyLineupDTO{}, e.NewBadRequestApiError("The fantasy lineup exceeds the budget cap")
+	}
+
+	// 5. Contar transferencias respecto de la alineación de la carrera anterior
+	transfers := 0
+	previous, apiErr := s.fantasyRepo.GetPreviousLineup(ctx, teamID, request.SessionID)
+	if apiErr != nil && apiErr.Status() != http.StatusNotFound {
+		return prodes.ResponseFantasyLineupDTO{}, apiErr
+	}
+	if previous != nil {
+		transfers = countTransfers(previous, request.DriverIDs, request.ConstructorName)
+	}
+
+	// 6. Crear o reemplazar la alineación de esta carrera
+	lineup := &model.FantasyLineup{
+		TeamID:    teamID,
+		SessionID: request.SessionID,
+	}
+	existing, apiErr := s.fantasyRepo.GetLineupsByTeam(ctx, teamID)
+	if apiErr != nil {
+		return prodes.ResponseFantasyLineupDTO{}, apiErr
+	}
+	for _, l := range existing {
+		if l.SessionID == request.SessionID {
+			lineup = l
+			break
+		}
+	}
+	lineup.Drivers = lineupDrivers
+	lineup.ConstructorName = request.ConstructorName
+	lineup.ConstructorCost = constructorCost
+	lineup.Cost = roundPrice(cost)
+	lineup.Transfers = transfers
+
+	if apiErr := s.fantasyRepo.SaveLineup(ctx, lineup); apiErr != nil {
+		return prodes.ResponseFantasyLineupDTO{}, apiErr
+	}
+
+	return toResponseFantasyLineupDTO(lineup), nil
+}
+
+// ScoreSession puntúa todas las alineaciones de una carrera y ajusta los precios de pilotos y escuderías
+func (s *fantasyService) ScoreSession(ctx context.Context, sessionID int) e.ApiError {
+	// 1. Evitar puntuar dos veces la misma carrera
+	if _, apiErr := s.fantasyRepo.GetRoundBySession(ctx, sessionID); apiErr == nil {
+		return e.NewConflictApiError("Fantasy scoring already processed for this session")
+	} else if apiErr.Status() != http.StatusNotFound {
+		return apiErr
+	}
+
+	sessionDetails, err := s.sessionClient.GetSessionByID(sessionID)
+	if err != nil {
+		return e.NewInternalServerApiError("Error fetching session details", err)
+	}
+	if !isRaceSession(sessionDetails.SessionName, sessionDetails.SessionType) {
+		return e.NewBadRequestApiError("Fantasy scoring is only available for race sessions")
+	}
+	year := sessionDetails.Year
+	if year == 0 {
+		year = sessionDetails.DateStart.Year()
+	}
+
+	// 2. Resultados, grilla y vuelta rápida
+	results, apiErr := s.fantasyRepo.GetRaceResults(ctx, sessionID)
+	if apiErr != nil {
+		return apiErr
+	}
+	if len(results) == 0 {
+		return e.NewBadRequestApiError("The session has no results yet")
+	}
+	grid, apiErr := s.fantasyRepo.GetGridPositions(ctx, sessionID)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	driverIDs := make([]int, 0, len(results))
+	for _, r := range results {
+		driverIDs = append(driverIDs, r.DriverID)
+	}
+	drivers, apiErr := s.fantasyRepo.GetDriversByIDs(ctx, driverIDs)
+	if apiErr != nil {
+		return apiErr
+	}
+	driverTeam := make(map[int]string, len(drivers))
+	for _, d := range drivers {
+		driverTeam[d.ID] = d.TeamName
+	}
+
+	// 3. Puntos de cada piloto y de cada escudería en la carrera
+	driverPoints, constructorPoints := calculateFantasyPoints(results, grid, driverTeam)
+
+	// 4. Puntuar las alineaciones; quien no armó alineación conserva la de la carrera anterior
+	teams, apiErr := s.fantasyRepo.GetTeamsByYear(ctx, year)
+	if apiErr != nil {
+		return apiErr
+	}
+	lineups, apiErr := s.fantasyRepo.GetLineupsBySession(ctx, sessionID)
+	if apiErr != nil {
+		return apiErr
+	}
+	lineupByTeam := make(map[int]*model.FantasyLineup, len(lineups))
+	for _, l := range lineups {
+		lineupByTeam[l.TeamID] = l
+	}
+
+	var scoredLineups []*model.FantasyLineup
+	teamPoints := make(map[int]int)
+	for _, team := range teams {
+		lineup, ok := lineupByTeam[team.ID]
+		if !ok {
+			previous, apiErr := s.fantasyRepo.GetPreviousLineup(ctx, team.ID, sessionID)
+			if apiErr != nil {
+				if apiErr.Status() == http.StatusNotFound {
+					continue
+				}
+				return apiErr
+			}
+			lineup = carryOverLineup(previous, sessionID)
+		}
+
+		points := 0
+		for i := range lineup.Drivers {
+			lineup.Drivers[i].Points = driverPoints[lineup.Drivers[i].DriverID]
+			points += lineup.Drivers[i].Points
+		}
+		if lineup.ConstructorName != nil {
+			points += constructorPoints[*lineup.ConstructorName]
+		}
+		if extra := lineup.Transfers - fantasyFreeTransfers; extra > 0 {
+			points -= extra * fantasyTransferPenalty
+		}
+		lineup.Points = points
+		lineup.Scored = true
+
+		scoredLineups = append(scoredLineups, lineup)
+		teamPoints[team.ID] = points
+	}
+
+	// 5. Ajustar precios según el rendimiento en la carrera
+	currentDriverPrices, currentConstructorPrices, apiErr := s.loadPrices(ctx, year)
+	if apiErr != nil {
+		return apiErr
+	}
+	var newDriverPrices []*model.FantasyDriverPrice
+	for driverID, points := range driverPoints {
+		newDriverPrices = append(newDriverPrices, &model.FantasyDriverPrice{
+			DriverID: driverID,
+			Year:     year,
+			Price:    adjustFantasyPrice(driverPrice(currentDriverPrices, driverID), points),
+		})
+	}
+	var newConstructorPrices []*model.FantasyConstructorPrice
+	for teamName, points := range constructorPoints {
+		if teamName == "" {
+			continue
+		}
+		newConstructorPrices = append(newConstructorPrices, &model.FantasyConstructorPrice{
+			TeamName: teamName,
+			Year:     year,
+			Price:    adjustFantasyPrice(constructorPrice(currentConstructorPrices, teamName), points/2),
+		})
+	}
+
+	// 6. Guardar todo en una transacción
+	round := &model.FantasyRound{SessionID: sessionID, Year: year}
+	return s.fantasyRepo.SaveRoundScoring(ctx, round, scoredLineups, teamPoints, newDriverPrices, newConstructorPrices)
+}
+
+func (s *fantasyService) GetLeaderboard(ctx context.Context, year, groupID int) ([]prodes.FantasyLeaderboardEntryDTO, e.ApiError) {
+	if year == 0 {
+		year = time.Now().Year()
+	}
+
+	teams, apiErr := s.fantasyRepo.GetLeaderboard(ctx, year, groupID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	leaderboard := make([]prodes.FantasyLeaderboardEntryDTO, 0, len(teams))
+	for i, team := range teams {
+		row := prodes.FantasyLeaderboardEntryDTO{
+			Position:    i + 1,
+			TeamID:      team.ID,
+			TeamName:    team.Name,
+			UserID:      team.UserID,
+			TotalPoints: team.TotalPoints,
+		}
+		if team.User != nil {
+			row.Username = team.User.Username
+		}
+		leaderboard = append(leaderboard, row)
+	}
+	return leaderboard, nil
+}
+
+// StartScoringJob puntúa periódicamente las carreras con resultados oficiales que todavía no fueron procesadas
+func (s *fantasyService) StartScoringJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sessionIDs, apiErr := s.fantasyRepo.GetSessionsPendingScoring(ctx)
+			if apiErr != nil {
+				log.Printf("Fantasy job: error buscando carreras pendientes: %v", apiErr)
+				continue
+			}
+			for _, sessionID := range sessionIDs {
+				if apiErr := s.ScoreSession(ctx, sessionID); apiErr != nil {
+					log.Printf("Fantasy job: error puntuando la sesión %d: %v", sessionID, apiErr)
+				}
+			}
+		}
+	}
+}
+
+// loadPrices devuelve los precios de la temporada indexados por piloto y por escudería
+func (s *fantasyService) loadPrices(ctx context.Context, year int) (map[int]float64, map[string]float64, e.ApiError) {
+	driverRows, apiErr := s.fantasyRepo.GetDriverPrices(ctx, year)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+	constructorRows, apiErr := s.fantasyRepo.GetConstructorPrices(ctx, year)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+
+	driverPrices := make(map[int]float64, len(driverRows))
+	for _, p := range driverRows {
+		driverPrices[p.DriverID] = p.Price
+	}
+	constructorPrices := make(map[string]float64, len(constructorRows))
+	for _, p := range constructorRows {
+		constructorPrices[p.TeamName] = p.Price
+	}
+	return driverPrices, constructorPrices, nil
+}
+
+// calculateFantasyPoints calcula los puntos de la carrera: posición final, posiciones ganadas
+// respecto de la grilla y vuelta rápida. Las escuderías suman los puntos por posición y vuelta rápida de sus pilotos.
+// Solo los pilotos que terminaron (FINISHED) suman por posición; el resto recibe la penalización de abandono.
+func calculateFantasyPoints(results []*model.Result, grid map[int]int, driverTeam map[int]string) (map[int]int, map[string]int) {
+	fastestDriverID := 0
+	fastestTime := 0.0
+	for _, r := range results {
+		if r.FastestLapTime > 0 && (fastestTime == 0 || r.FastestLapTime < fastestTime) {
+			fastestTime = r.FastestLapTime
+			fastestDriverID = r.DriverID
+		}
+	}
+
+	driverPoints := make(map[int]int, len(results))
+	constructorPoints := make(map[string]int)
+	for _, r := range results {
+		points := 0
+		finish := 0
+		if r.Status != "FINISHED" || r.Position == nil || *r.Position <= 0 {
+			points = fantasyDNFPoints
+		} else {
+			pos := *r.Position
+			if pos <= len(fantasyFinishPoints) {
+				finish = fantasyFinishPoints[pos-1]
+			}
+			points = finish
+			if start, ok := grid[r.DriverID]; ok {
+				gained := start - pos
+				if gained > fantasyMaxPositionsDelta {
+					gained = fantasyMaxPositionsDelta
+				} else if gained < -fantasyMaxPositionsDelta {
+					gained = -fantasyMaxPositionsDelta
+				}
+				points += gained
+			}
+		}
+		if r.DriverID == fastestDriverID {
+			points += fantasyFastestLapPoints
+			finish += fantasyFastestLapPoints
+		}
+
+		driverPoints[r.DriverID] = points
+		constructorPoints[driverTeam[r.DriverID]] += finish
+	}
+	return driverPoints, constructorPoints
+}
+
+// adjustFantasyPrice sube o baja el precio según los puntos obtenidos en la carrera
+func adjustFantasyPrice(price float64, points int) float64 {
+	switch {
+	case points >= 25:
+		price += 0.5
+	case points >= 15:
+		price += 0.3
+	case points >= 8:
+		price += 0.1
+	case points <= 0:
+		price -= 0.3
+	default:
+		price -= 0.1
+	}
+	return roundPrice(math.Min(math.Max(price, fantasyMinPrice), fantasyMaxPrice))
+}
+
+func countTransfers(previous *model.FantasyLineup, driverIDs []int, constructorName *string) int {
+	previousDrivers := make(map[int]bool, len(previous.Drivers))
+	for _, d := range previous.Drivers {
+		previousDrivers[d.DriverID] = true
+	}
+	transfers := 0
+	for _, id := range driverIDs {
+		if !previousDrivers[id] {
+			transfers++
+		}
+	}
+	prevConstructor, newConstructor := "", ""
+	if previous.ConstructorName != nil {
+		prevConstructor = *previous.ConstructorName
+	}
+	if constructorName != nil {
+		newConstructor = *constructorName
+	}
+	if prevConstructor != newConstructor {
+		transfers++
+	}
+	return transfers
+}
+
+// carryOverLineup copia la alineación anterior para una carrera en la que el equipo no hizo cambios
+func carryOverLineup(previous *model.FantasyLineup, sessionID int) *model.FantasyLineup {
+	lineup := &model.FantasyLineup{
+		TeamID:          previous.TeamID,
+		SessionID:       sessionID,
+		ConstructorName: previous.ConstructorName,
+		ConstructorCost: previous.ConstructorCost,
+		Cost:            previous.Cost,
+	}
+	for _, d := range previous.Drivers {
+		lineup.Drivers = append(lineup.Drivers, model.FantasyLineupDriver{DriverID: d.DriverID, Price: d.Price})
+	}
+	return lineup
+}
+
+func driverPrice(prices map[int]float64, driverID int) float64 {
+	if price, ok := prices[driverID]; ok {
+		return price
+	}
+	return fantasyDefaultDriverPrice
+}
+
+func constructorPrice(prices map[string]float64, teamName string) float64 {
+	if price, ok := prices[teamName]; ok {
+		return price
+	}
+	return fantasyDefaultTeamPrice
+}
+
+func roundPrice(price float64) float64 {
+	return math.Round(price*10) / 10
+}
+
+func toResponseFantasyLineupDTO(lineup *model.FantasyLineup) prodes.ResponseFantasyLineupDTO {
+	drivers := make([]prodes.FantasyLineupDriverDTO, 0, len(lineup.Drivers))
+	for _, d := range lineup.Drivers {
+		drivers = append(drivers, prodes.FantasyLineupDriverDTO{
+			DriverID: d.DriverID,
+			Price:    d.Price,
+			Points:   d.Points,
+		})
+	}
+	return prodes.ResponseFantasyLineupDTO{
+		ID:              lineup.ID,
+		SessionID:       lineup.SessionID,
+		Drivers:         drivers,
+		ConstructorName: lineup.ConstructorName,
+		ConstructorCost: lineup.ConstructorCost,
+		Cost:            lineup.Cost,
+		Transfers:       lineup.Transfers,
+		Points:          lineup.Points,
+		Scored:          lineup.Scored,
+	}
+}
+
+func toResponseFantasyTeamDTO(team *model.FantasyTeam, lineups []*model.FantasyLineup) prodes.ResponseFantasyTeamDTO {
+	response := prodes.ResponseFantasyTeamDTO{
+		ID:          team.ID,
+		UserID:      team.UserID,
+		Year:        team.Year,
+		Name:        team.Name,
+		TotalPoints: team.TotalPoints,
+	}
+	for _, l := range lineups {
+		response.Lineups = append(response.Lineups, toResponseFantasyLineupDTO(l))
+	}
+	return response
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"prediapp.local/db/model"
+)
+
+func TestCalculateFantasyPoints(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	result := func(driverID int, status string, position *int, fastestLap float64) *model.Result {
+		return &model.Result{DriverID: driverID, Status: status, Position: position, FastestLapTime: fastestLap}
+	}
+	driverTeam := map[int]string{1: "Red Bull", 2: "Red Bull", 3: "Ferrari", 4: "Ferrari"}
+
+	tests := []struct {
+		name            string
+		results         []*model.Result
+		grid            map[int]int
+		wantDrivers     map[int]int
+		wantConstructor map[string]int
+	}{
+		{
+			name: "posición, posiciones ganadas y vuelta rápida",
+			results: []*model.Result{
+				result(1, "FINISHED", intPtr(1), 91.2),
+				result(2, "FINISHED", intPtr(2), 90.8),
+				result(3, "FINISHED", intPtr(3), 0),
+			},
+			grid:            map[int]int{1: 3, 2: 1, 3: 3},
+			wantDrivers:     map[int]int{1: 27, 2: 22, 3: 15},
+			wantConstructor: map[string]int{"Red Bull": 48, "Ferrari": 15},
+		},
+		{
+			name: "las posiciones ganadas y perdidas tienen tope",
+			results: []*model.Result{
+				result(1, "FINISHED", intPtr(4), 0),
+				result(3, "FINISHED", intPtr(20), 0),
+			},
+			grid:            map[int]int{1: 20, 3: 2},
+			wantDrivers:     map[int]int{1: 22, 3: -10},
+			wantConstructor: map[string]int{"Red Bull": 12, "Ferrari": 0},
+		},
+		{
+			name: "sin grilla solo cuenta la posición",
+			results: []*model.Result{
+				result(1, "FINISHED", intPtr(10), 0),
+				result(2, "FINISHED", intPtr(11), 0),
+			},
+			grid:            map[int]int{},
+			wantDrivers:     map[int]int{1: 1, 2: 0},
+			wantConstructor: map[string]int{"Red Bull": 1},
+		},
+		{
+			name: "DNF con posición no suma por posición",
+			results: []*model.Result{
+				result(1, "FINISHED", intPtr(1), 0),
+				result(3, "DNF", intPtr(2), 0),
+				result(4, "DSQ", nil, 0),
+			},
+			grid:            map[int]int{1: 1, 3: 5, 4: 2},
+			wantDrivers:     map[int]int{1: 25, 3: -5, 4: -5},
+			wantConstructor: map[string]int{"Red Bull": 25, "Ferrari": 0},
+		},
+		{
+			name: "vuelta rápida de un piloto que abandonó",
+			results: []*model.Result{
+				result(1, "FINISHED", intPtr(1), 92.0),
+				result(3, "DNF", nil, 89.5),
+			},
+			grid:            map[int]int{1: 1, 3: 2},
+			wantDrivers:     map[int]int{1: 25, 3: 0},
+			wantConstructor: map[string]int{"Red Bull": 25, "Ferrari": 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drivers, constructors := calculateFantasyPoints(tt.results, tt.grid, driverTeam)
+			if !reflect.DeepEqual(drivers, tt.wantDrivers) {
+				t.Errorf("puntos de pilotos = %v, want %v", drivers, tt.wantDrivers)
+			}
+			if !reflect.DeepEqual(constructors, tt.wantConstructor) {
+				t.Errorf("puntos de escuderías = %v, want %v", constructors, tt.wantConstructor)
+			}
+		})
+	}
+}
+
+func TestAdjustFantasyPrice(t *testing.T) {
+	tests := []struct {
+		name   string
+		price  float64
+		points int
+		want   float64
+	}{
+		{"victoria", 20, 30, 20.5},
+		{"buen resultado", 20, 15, 20.3},
+		{"puntos", 20, 8, 20.1},
+		{"pocos puntos", 20, 4, 19.9},
+		{"sin puntos", 20, 0, 19.7},
+		{"abandono", 20, -5, 19.7},
+		{"no supera el máximo", 34.8, 25, 35},
+		{"no baja del mínimo", 4.6, -5, 4.5},
+		{"redondea a un decimal", 10.05, 8, 10.2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := adjustFantasyPrice(tt.price, tt.points); got != tt.want {
+				t.Errorf("adjustFantasyPrice(%v, %d) = %v, want %v", tt.price, tt.points, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountTransfers(t *testing.T) {
+	strPtr := func(v string) *string { return &v }
+	previous := &model.FantasyLineup{
+		ConstructorName: strPtr("Ferrari"),
+		Drivers: []model.FantasyLineupDriver{
+			{DriverID: 1}, {DriverID: 2}, {DriverID: 3}, {DriverID: 4}, {DriverID: 5},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		drivers     []int
+		constructor *string
+		want        int
+	}{
+		{"sin cambios", []int{5, 4, 3, 2, 1}, strPtr("Ferrari"), 0},
+		{"un piloto", []int{1, 2, 3, 4, 6}, strPtr("Ferrari"), 1},
+		{"pilotos y escudería", []int{1, 2, 3, 7, 6}, strPtr("McLaren"), 3},
+		{"deja de tener escudería", []int{1, 2, 3, 4, 5}, nil, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countTransfers(previous, tt.drivers, tt.constructor); got != tt.want {
+				t.Errorf("countTransfers() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
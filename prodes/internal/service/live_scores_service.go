@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+
+	prodes "prediapp.local/prodes/internal/dto"
+	e "prediapp.local/prodes/pkg/utils"
+)
+
+// GetLiveScores calcula los puntajes provisorios de los prodes de una sesión en curso con las posiciones en vivo
+// del microservicio de results, usando el mismo cálculo que la puntuación real. No guarda nada.
+// Con userID distinto de 0 devuelve solo los prodes de ese usuario.
+func (s *prodeService) GetLiveScores(ctx context.Context, sessionID int, userID int) (prodes.LiveScoresDTO, e.ApiError) {
+	standings, err := s.resultsClient.GetLiveStandings(sessionID)
+	if err != nil {
+		return prodes.LiveScoresDTO{}, e.NewInternalServerApiError("Error fetching live standings from results service", err)
+	}
+
+	sessionDetails, err := s.sessionClient.GetSessionByID(sessionID)
+	if err != nil {
+		return prodes.LiveScoresDTO{}, e.NewInternalServerApiError("Error fetching session details", err)
+	}
+
+	// Top 5 en carrera, top 3 en el resto, igual que al puntuar los resultados oficiales
+	n := 3
+	if isRaceSession(sessionDetails.SessionName, sessionDetails.SessionType) {
+		n = 5
+	}
+	topDrivers := liveTopDrivers(standings, n)
+
+	response := prodes.LiveScoresDTO{
+		SessionID:   sessionID,
+		Provisional: true,
+		Finished:    standings.Finished,
+		UpdatedAt:   standings.UpdatedAt,
+		TopDrivers:  topDrivers,
+		Scores:      make([]prodes.LiveProdeScoreDTO, 0),
+	}
+	addScore := func(prodeID, prodeUserID, score, storedScore int) {
+		if userID != 0 && prodeUserID != userID {
+			return
+		}
+		response.Scores = append(response.Scores, prodes.LiveProdeScoreDTO{
+			ProdeID:     prodeID,
+			UserID:      prodeUserID,
+			Score:       score,
+			StoredScore: storedScore,
+		})
+	}
+
+	if n == 5 {
+		raceProdes, newScores, apiErr := s.planRaceScores(ctx, sessionID, topDrivers)
+		if apiErr != nil {
+			return prodes.LiveScoresDTO{}, apiErr
+		}
+		for _, prode := range raceProdes {
+			addScore(prode.ID, prode.UserID, newScores[prode.ID], prode.Score)
+		}
+		return response, nil
+	}
+
+	prodesSession, err := s.prodeRepo.GetSessionProdesBySession(ctx, sessionID)
+	if err != nil {
+		return prodes.LiveScoresDTO{}, e.NewInternalServerApiError("Error fetching prodes session for scoring", err)
+	}
+	for _, prode := range prodesSession {
+		addScore(prode.ID, prode.UserID, calculateSessionScore(prode, topDrivers), prode.Score)
+	}
+	return response, nil
+}
+
+// liveTopDrivers toma los primeros n pilotos de las posiciones en vivo que están cargados en la base
+func liveTopDrivers(standings prodes.LiveStandingsDTO, n int) []prodes.TopDriverDTO {
+	topDrivers := make([]prodes.TopDriverDTO, 0, n)
+	for _, position := range standings.Positions {
+		if position.Position > n {
+			break
+		}
+		if position.Driver.ID == 0 {
+			continue
+		}
+		topDrivers = append(topDrivers, prodes.TopDriverDTO{Position: position.Position, DriverID: position.Driver.ID})
+	}
+	return topDrivers
+}
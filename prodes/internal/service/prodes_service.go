@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"time"
 
 	model "prediapp.local/db/model"
@@ -41,6 +42,15 @@ type ProdeServiceInterface interface {
 	GetProdeByUserAndSession(ctx context.Context, userID int, sessionID int) (*prodes.ResponseProdeCarreraDTO, *prodes.ResponseProdeSessionDTO, e.ApiError)
 	UpdateScoresForRaceProdes(ctx context.Context, sessionID int) e.ApiError
 	UpdateScoresForSessionProdes(ctx context.Context, sessionID int) e.ApiError
+	PreviewRaceScores(ctx context.Context, sessionID int, topDrivers []prodes.TopDriverDTO) (prodes.ScorePreviewDTO, e.ApiError)
+	PreviewSessionScores(ctx context.Context, sessionID int, topDrivers []prodes.TopDriverDTO) (prodes.ScorePreviewDTO, e.ApiError)
+	GetLiveScores(ctx context.Context, sessionID int, userID int) (prodes.LiveScoresDTO, e.ApiError)
+	CreateRuleset(ctx context.Context, request prodes.CreateRulesetDTO) (prodes.ResponseRulesetDTO, e.ApiError)
+	GetProps(ctx context.Context) ([]prodes.ResponsePropDTO, e.ApiError)
+	CreateProp(ctx context.Context, request prodes.CreatePropDTO) (prodes.ResponsePropDTO, e.ApiError)
+	UpdateProp(ctx context.Context, propID int, request prodes.UpdatePropDTO) (prodes.ResponsePropDTO, e.ApiError)
+	DeleteProp(ctx context.Context, propID int) e.ApiError
+	GetRulesets(ctx context.Context) ([]prodes.ResponseRulesetDTO, e.ApiError)
 	// UpdateUserScores(ctx context.Context) e.ApiError
 }
 
@@ -71,9 +81,12 @@ func (s *prodeService) CreateProdeCarrera(ctx context.Context, request prodes.Cr
 			P4:        request.P4,
 			P5:        request.P5,
 			// FastestLap: request.FastestLap,
-			VSC: request.VSC,
-			SC:  request.SC,
-			DNF: request.DNF,
+			VSC:        request.VSC,
+			SC:         request.SC,
+			DNF:        request.DNF,
+			RulesetID:  request.RulesetID,
+			Confidence: request.Confidence,
+			Props:      request.Props,
 		}
 		return s.UpdateProdeCarrera(ctx, updateRequest)
 	}
@@ -89,6 +102,16 @@ func (s *prodeService) CreateProdeCarrera(ctx context.Context, request prodes.Cr
 		return prodes.ResponseProdeCarreraDTO{}, e.NewBadRequestApiError("La sesión asociada no es una carrera válida (Race), no se puede crear un ProdeCarrera")
 	}
 
+	// Validar el reparto de puntos de confianza según el ruleset elegido
+	if apiErr := s.validateConfidenceAllocation(ctx, request.RulesetID, request.Confidence); apiErr != nil {
+		return prodes.ResponseProdeCarreraDTO{}, apiErr
+	}
+
+	// Validar las respuestas a los props extendidos
+	if apiErr := s.validatePropAnswers(ctx, request.Props); apiErr != nil {
+		return prodes.ResponseProdeCarreraDTO{}, apiErr
+	}
+
 	// Convertir DTO a modelo
 	prode := model.ProdeCarrera{
 		UserID:    request.UserID,
@@ -99,11 +122,13 @@ func (s *prodeService) CreateProdeCarrera(ctx context.Context, request prodes.Cr
 		P4:        request.P4,
 		P5:        request.P5,
 		// FastestLap: request.FastestLap,
-		VSC:   request.VSC,
-		SC:    request.SC,
-		DNF:   request.DNF,
-		Score: 0,
+		VSC:       request.VSC,
+		SC:        request.SC,
+		DNF:       request.DNF,
+		RulesetID: request.RulesetID,
+		Score:     0,
 	}
+	applyConfidenceAllocation(&prode, request.Confidence)
 
 	// Crear el pronóstico de carrera en la base de datos
 	err = s.prodeRepo.CreateProdeCarrera(ctx, &prode)
@@ -111,6 +136,10 @@ func (s *prodeService) CreateProdeCarrera(ctx context.Context, request prodes.Cr
 		return prodes.ResponseProdeCarreraDTO{}, e.NewInternalServerApiError("Error creando el pronóstico de carrera", err)
 	}
 
+	if apiErr := s.saveProdeCarreraProps(ctx, prode.ID, request.Props); apiErr != nil {
+		return prodes.ResponseProdeCarreraDTO{}, apiErr
+	}
+
 	// // Invalidar caché relevante
 	// cacheKeys := []string{
 	// 	fmt.Sprintf("prode:user:%d", request.UserID),
@@ -133,10 +162,13 @@ func (s *prodeService) CreateProdeCarrera(ctx context.Context, request prodes.Cr
 		P4:        prode.P4,
 		P5:        prode.P5,
 		// FastestLap: prode.FastestLap,
-		VSC:   prode.VSC,
-		SC:    prode.SC,
-		DNF:   prode.DNF,
-		Score: prode.Score,
+		VSC:        prode.VSC,
+		SC:         prode.SC,
+		DNF:        prode.DNF,
+		RulesetID:  prode.RulesetID,
+		Confidence: s.confidenceAllocationFromModel(ctx, &prode, map[int]*model.Ruleset{}),
+		Props:      s.getPropAnswers(ctx, prode.ID),
+		Score:      prode.Score,
 	}
 
 	return response, nil
@@ -228,6 +260,18 @@ func (s *prodeService) UpdateProdeCarrera(ctx context.Context, request prodes.Up
 		return prodes.ResponseProdeCarreraDTO{}, e.NewForbiddenApiError("No se puede actualizar el pronóstico, la carrera ya ha comenzado.")
 	}
 
+	// Si no se indica ruleset se mantiene el que ya tenía el pronóstico
+	rulesetID := request.RulesetID
+	if rulesetID == nil {
+		rulesetID = existingProde.RulesetID
+	}
+	if apiErr := s.validateConfidenceAllocation(ctx, rulesetID, request.Confidence); apiErr != nil {
+		return prodes.ResponseProdeCarreraDTO{}, apiErr
+	}
+	if apiErr := s.validatePropAnswers(ctx, request.Props); apiErr != nil {
+		return prodes.ResponseProdeCarreraDTO{}, apiErr
+	}
+
 	// Proceder con la actualización del ProdeCarrera
 	// Aquí usamos los valores originales de SessionID y UserID para evitar cambios no permitidos
 	prode := model.ProdeCarrera{
@@ -243,15 +287,21 @@ func (s *prodeService) UpdateProdeCarrera(ctx context.Context, request prodes.Up
 		VSC:       request.VSC,
 		SC:        request.SC,
 		DNF:       request.DNF,
+		RulesetID: rulesetID,
 		CreatedAt: existingProde.CreatedAt,
 		UpdatedAt: time.Now(),
 	}
+	applyConfidenceAllocation(&prode, request.Confidence)
 
 	err = s.prodeRepo.UpdateProdeCarrera(ctx, &prode)
 	if err != nil {
 		return prodes.ResponseProdeCarreraDTO{}, e.NewInternalServerApiError("Error actualizando el pronóstico de carrera", err)
 	}
 
+	if apiErr := s.saveProdeCarreraProps(ctx, prode.ID, request.Props); apiErr != nil {
+		return prodes.ResponseProdeCarreraDTO{}, apiErr
+	}
+
 	// // Invalidar caché relevante
 	// cacheKeys := []string{
 	// 	fmt.Sprintf("prode:user:%d", prode.UserID),
@@ -273,10 +323,13 @@ func (s *prodeService) UpdateProdeCarrera(ctx context.Context, request prodes.Up
 		P4:        prode.P4,
 		P5:        prode.P5,
 		// FastestLap: prode.FastestLap,
-		VSC:   prode.VSC,
-		SC:    prode.SC,
-		DNF:   prode.DNF,
-		Score: prode.Score,
+		VSC:        prode.VSC,
+		SC:         prode.SC,
+		DNF:        prode.DNF,
+		RulesetID:  prode.RulesetID,
+		Confidence: s.confidenceAllocationFromModel(ctx, &prode, map[int]*model.Ruleset{}),
+		Props:      s.getPropAnswers(ctx, prode.ID),
+		Score:      prode.Score,
 	}
 
 	return response, nil
@@ -428,21 +481,24 @@ func (s *prodeService) GetProdesByUserId(ctx context.Context, userID int) ([]pro
 		return nil, nil, e.NewInternalServerApiError("Error fetching prodes by user ID", err)
 	}
 
+	rulesets := make(map[int]*model.Ruleset)
 	var carreraResponses []prodes.ResponseProdeCarreraDTO
 	for _, prode := range carreraProdes {
 		carreraResponses = append(carreraResponses, prodes.ResponseProdeCarreraDTO{
-			ID:        prode.ID,
-			UserID:    prode.UserID,
-			SessionID: prode.SessionID,
-			P1:        prode.P1,
-			P2:        prode.P2,
-			P3:        prode.P3,
-			P4:        prode.P4,
-			P5:        prode.P5,
-			VSC:       prode.VSC,
-			SC:        prode.SC,
-			DNF:       prode.DNF,
-			Score:     prode.Score,
+			ID:         prode.ID,
+			UserID:     prode.UserID,
+			SessionID:  prode.SessionID,
+			P1:         prode.P1,
+			P2:         prode.P2,
+			P3:         prode.P3,
+			P4:         prode.P4,
+			P5:         prode.P5,
+			VSC:        prode.VSC,
+			SC:         prode.SC,
+			DNF:        prode.DNF,
+			RulesetID:  prode.RulesetID,
+			Confidence: s.confidenceAllocationFromModel(ctx, prode, rulesets),
+			Score:      prode.Score,
 		})
 	}
 
@@ -503,18 +559,21 @@ func (s *prodeService) GetProdeByUserAndSession(ctx context.Context, userID, ses
 
 		if prode != nil {
 			carreraResponse = &prodes.ResponseProdeCarreraDTO{
-				ID:        prode.ID,
-				UserID:    prode.UserID,
-				SessionID: prode.SessionID,
-				P1:        prode.P1,
-				P2:        prode.P2,
-				P3:        prode.P3,
-				P4:        prode.P4,
-				P5:        prode.P5,
-				VSC:       prode.VSC,
-				SC:        prode.SC,
-				DNF:       prode.DNF,
-				Score:     prode.Score,
+				ID:         prode.ID,
+				UserID:     prode.UserID,
+				SessionID:  prode.SessionID,
+				P1:         prode.P1,
+				P2:         prode.P2,
+				P3:         prode.P3,
+				P4:         prode.P4,
+				P5:         prode.P5,
+				VSC:        prode.VSC,
+				SC:         prode.SC,
+				DNF:        prode.DNF,
+				RulesetID:  prode.RulesetID,
+				Confidence: s.confidenceAllocationFromModel(ctx, prode, map[int]*model.Ruleset{}),
+				Props:      s.getPropAnswers(ctx, prode.ID),
+				Score:      prode.Score,
 			}
 		}
 	} else {
@@ -574,21 +633,24 @@ func (s *prodeService) GetRaceProdesBySession(ctx context.Context, sessionID int
 		return nil, e.NewInternalServerApiError("Error fetching race prodes for the session", err)
 	}
 
+	rulesets := make(map[int]*model.Ruleset)
 	var raceProdeResponses []prodes.ResponseProdeCarreraDTO
 	for _, prode := range raceProdes {
 		raceProdeResponses = append(raceProdeResponses, prodes.ResponseProdeCarreraDTO{
-			ID:        prode.ID,
-			UserID:    prode.UserID,
-			SessionID: prode.SessionID,
-			P1:        prode.P1,
-			P2:        prode.P2,
-			P3:        prode.P3,
-			P4:        prode.P4,
-			P5:        prode.P5,
-			VSC:       prode.VSC,
-			SC:        prode.SC,
-			DNF:       prode.DNF,
-			Score:     prode.Score,
+			ID:         prode.ID,
+			UserID:     prode.UserID,
+			SessionID:  prode.SessionID,
+			P1:         prode.P1,
+			P2:         prode.P2,
+			P3:         prode.P3,
+			P4:         prode.P4,
+			P5:         prode.P5,
+			VSC:        prode.VSC,
+			SC:         prode.SC,
+			DNF:        prode.DNF,
+			RulesetID:  prode.RulesetID,
+			Confidence: s.confidenceAllocationFromModel(ctx, prode, rulesets),
+			Score:      prode.Score,
 		})
 	}
 
@@ -617,6 +679,24 @@ func (s *prodeService) UpdateRaceProdeForUserBySessionId(ctx context.Context, us
 		return prodes.ResponseProdeCarreraDTO{}, e.NewForbiddenApiError("No se puede actualizar el pronóstico, la carrera ya ha comenzado")
 	}
 
+	// Si no se indica ruleset se mantiene el que ya tenía el pronóstico
+	rulesetID := updatedProde.RulesetID
+	if rulesetID == nil {
+		existingProde, apiErr := s.prodeRepo.GetProdeCarreraByUserAndSession(ctx, userID, sessionID)
+		if apiErr != nil {
+			return prodes.ResponseProdeCarreraDTO{}, apiErr
+		}
+		if existingProde != nil {
+			rulesetID = existingProde.RulesetID
+		}
+	}
+	if apiErr := s.validateConfidenceAllocation(ctx, rulesetID, updatedProde.Confidence); apiErr != nil {
+		return prodes.ResponseProdeCarreraDTO{}, apiErr
+	}
+	if apiErr := s.validatePropAnswers(ctx, updatedProde.Props); apiErr != nil {
+		return prodes.ResponseProdeCarreraDTO{}, apiErr
+	}
+
 	prode := model.ProdeCarrera{
 		ID:        updatedProde.ProdeID,
 		UserID:    userID,
@@ -629,13 +709,19 @@ func (s *prodeService) UpdateRaceProdeForUserBySessionId(ctx context.Context, us
 		VSC:       updatedProde.VSC,
 		SC:        updatedProde.SC,
 		DNF:       updatedProde.DNF,
+		RulesetID: rulesetID,
 	}
+	applyConfidenceAllocation(&prode, updatedProde.Confidence)
 
 	err = s.prodeRepo.UpdateProdeCarrera(ctx, &prode)
 	if err != nil {
 		return prodes.ResponseProdeCarreraDTO{}, e.NewInternalServerApiError("Error actualizando el pronóstico de carrera", err)
 	}
 
+	if apiErr := s.saveProdeCarreraProps(ctx, prode.ID, updatedProde.Props); apiErr != nil {
+		return prodes.ResponseProdeCarreraDTO{}, apiErr
+	}
+
 	// Invalidar caché relevante
 	// cacheKeys := []string{
 	// 	fmt.Sprintf("prode:user:%d", userID),
@@ -648,18 +734,21 @@ func (s *prodeService) UpdateRaceProdeForUserBySessionId(ctx context.Context, us
 	// }
 
 	response := prodes.ResponseProdeCarreraDTO{
-		ID:        prode.ID,
-		UserID:    prode.UserID,
-		SessionID: prode.SessionID,
-		P1:        prode.P1,
-		P2:        prode.P2,
-		P3:        prode.P3,
-		P4:        prode.P4,
-		P5:        prode.P5,
-		VSC:       prode.VSC,
-		SC:        prode.SC,
-		DNF:       prode.DNF,
-		Score:     prode.Score,
+		ID:         prode.ID,
+		UserID:     prode.UserID,
+		SessionID:  prode.SessionID,
+		P1:         prode.P1,
+		P2:         prode.P2,
+		P3:         prode.P3,
+		P4:         prode.P4,
+		P5:         prode.P5,
+		VSC:        prode.VSC,
+		SC:         prode.SC,
+		DNF:        prode.DNF,
+		RulesetID:  prode.RulesetID,
+		Confidence: s.confidenceAllocationFromModel(ctx, &prode, map[int]*model.Ruleset{}),
+		Props:      s.getPropAnswers(ctx, prode.ID),
+		Score:      prode.Score,
 	}
 
 	return response, nil
@@ -734,21 +823,24 @@ func (s *prodeService) GetUserProdes(ctx context.Context, userID int) ([]prodes.
 		return nil, nil, e.NewInternalServerApiError("Error fetching user prodes", err)
 	}
 
+	rulesets := make(map[int]*model.Ruleset)
 	var carreraResponses []prodes.ResponseProdeCarreraDTO
 	for _, prode := range carreraProdes {
 		carreraResponses = append(carreraResponses, prodes.ResponseProdeCarreraDTO{
-			ID:        prode.ID,
-			UserID:    prode.UserID,
-			SessionID: prode.SessionID,
-			P1:        prode.P1,
-			P2:        prode.P2,
-			P3:        prode.P3,
-			P4:        prode.P4,
-			P5:        prode.P5,
-			VSC:       prode.VSC,
-			SC:        prode.SC,
-			DNF:       prode.DNF,
-			Score:     prode.Score,
+			ID:         prode.ID,
+			UserID:     prode.UserID,
+			SessionID:  prode.SessionID,
+			P1:         prode.P1,
+			P2:         prode.P2,
+			P3:         prode.P3,
+			P4:         prode.P4,
+			P5:         prode.P5,
+			VSC:        prode.VSC,
+			SC:         prode.SC,
+			DNF:        prode.DNF,
+			RulesetID:  prode.RulesetID,
+			Confidence: s.confidenceAllocationFromModel(ctx, prode, rulesets),
+			Score:      prode.Score,
 		})
 	}
 
@@ -849,13 +941,54 @@ func (s *prodeService) GetTopDriversBySessionId(ctx context.Context, sessionID,
 }
 
 func (s *prodeService) UpdateScoresForRaceProdes(ctx context.Context, sessionID int) e.ApiError {
+	realTopDrivers, err := s.resultsClient.GetTopDriversBySession(sessionID, 5)
+	if err != nil {
+		return e.NewInternalServerApiError("Error fetching top 5 drivers for race session", err)
+	}
+
+	raceProdes, newScores, apiErr := s.planRaceScores(ctx, sessionID, realTopDrivers)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	// Acumular deltas por usuario
+	deltaPorUsuario := make(map[int]int)
+	for _, prode := range raceProdes {
+		delta := newScores[prode.ID] - prode.Score
+		if delta == 0 {
+			continue
+		}
+		prode.Score = newScores[prode.ID]
+		deltaPorUsuario[prode.UserID] += delta
+	}
+
+	// Persistir prodes actualizados
+	for _, prode := range raceProdes {
+		if err := s.prodeRepo.UpdateProdeCarrera(ctx, prode); err != nil {
+			return e.NewInternalServerApiError("Error updating race prode score", err)
+		}
+	}
+
+	// Aplicar deltas acumulados a cada user
+	for userID, delta := range deltaPorUsuario {
+		if apiErr := s.prodeRepo.IncrementUserScore(ctx, userID, sessionID, delta); apiErr != nil {
+			return e.NewInternalServerApiError("Error updating user total score", apiErr)
+		}
+	}
+
+	return nil
+}
+
+// planRaceScores calcula el puntaje que le corresponde a cada prode de carrera (prodeID -> score) con el top 5
+// recibido, sin persistir. Lo comparten el recálculo real y la simulación.
+func (s *prodeService) planRaceScores(ctx context.Context, sessionID int, realTopDrivers []prodes.TopDriverDTO) ([]*model.ProdeCarrera, map[int]int, e.ApiError) {
 	sessionDetails, err := s.sessionClient.GetSessionByID(sessionID)
 	if err != nil {
-		return e.NewInternalServerApiError("Error fetching session details", err)
+		return nil, nil, e.NewInternalServerApiError("Error fetching session details", err)
 	}
 
 	if !isRaceSession(sessionDetails.SessionName, sessionDetails.SessionType) {
-		return e.NewBadRequestApiError("La sesión no es de tipo 'Race'; no se pueden recalcular prodes carrera")
+		return nil, nil, e.NewBadRequestApiError("La sesión no es de tipo 'Race'; no se pueden recalcular prodes carrera")
 	}
 
 	// Valores reales con defaults
@@ -872,45 +1005,33 @@ func (s *prodeService) UpdateScoresForRaceProdes(ctx context.Context, sessionID
 		realDNF = *sessionDetails.DNF
 	}
 
-	realTopDrivers, err := s.resultsClient.GetTopDriversBySession(sessionID, 5)
-	if err != nil {
-		return e.NewInternalServerApiError("Error fetching top 5 drivers for race session", err)
-	}
-
 	raceProdes, err := s.prodeRepo.GetRaceProdesBySession(ctx, sessionID)
 	if err != nil {
-		return e.NewInternalServerApiError("Error fetching race prodes for session", err)
+		return nil, nil, e.NewInternalServerApiError("Error fetching race prodes for session", err)
 	}
 
-	// Acumular deltas por usuario
-	deltaPorUsuario := make(map[int]int)
+	// Rulesets ya consultados, para no ir a la base por cada prode
+	rulesets := make(map[int]*model.Ruleset)
 
-	// Calcular nuevos scores y acumular deltas
-	for _, prode := range raceProdes {
-		newScore := calculateRaceScore(prode, realTopDrivers, realVSC, realSC, realDNF)
-		delta := newScore - prode.Score
-		if delta == 0 {
-			continue
-		}
-		prode.Score = newScore
-		deltaPorUsuario[prode.UserID] += delta
+	// Puntaje de los props extendidos por prode
+	propsScore, apiErr := s.scoreRaceProps(ctx, sessionID)
+	if apiErr != nil {
+		return nil, nil, apiErr
 	}
 
-	// Persistir prodes actualizados
+	// Calcular nuevos scores
+	newScores := make(map[int]int, len(raceProdes))
 	for _, prode := range raceProdes {
-		if err := s.prodeRepo.UpdateProdeCarrera(ctx, prode); err != nil {
-			return e.NewInternalServerApiError("Error updating race prode score", err)
+		var newScore int
+		if s.isConfidenceProde(ctx, prode, rulesets) {
+			newScore = calculateConfidenceRaceScore(prode, realTopDrivers, realVSC, realSC, realDNF)
+		} else {
+			newScore = calculateRaceScore(prode, realTopDrivers, realVSC, realSC, realDNF)
 		}
+		newScores[prode.ID] = newScore + propsScore[prode.ID]
 	}
 
-	// Aplicar deltas acumulados a cada user
-	for userID, delta := range deltaPorUsuario {
-		if apiErr := s.prodeRepo.IncrementUserScore(ctx, userID, delta); apiErr != nil {
-			return e.NewInternalServerApiError("Error updating user total score", apiErr)
-		}
-	}
-
-	return nil
+	return raceProdes, newScores, nil
 }
 
 func (s *prodeService) UpdateScoresForSessionProdes(ctx context.Context, sessionID int) e.ApiError {
@@ -943,7 +1064,7 @@ func (s *prodeService) UpdateScoresForSessionProdes(ctx context.Context, session
 	}
 
 	for userID, delta := range deltaPorUsuario {
-		if apiErr := s.prodeRepo.IncrementUserScore(ctx, userID, delta); apiErr != nil {
+		if apiErr := s.prodeRepo.IncrementUserScore(ctx, userID, sessionID, delta); apiErr != nil {
 			return e.NewInternalServerApiError("Error updating user total score", apiErr)
 		}
 	}
@@ -951,6 +1072,100 @@ func (s *prodeService) UpdateScoresForSessionProdes(ctx context.Context, session
 	return nil
 }
 
+// PreviewRaceScores simula el recálculo de los prodes de carrera con una clasificación hipotética
+func (s *prodeService) PreviewRaceScores(ctx context.Context, sessionID int, topDrivers []prodes.TopDriverDTO) (prodes.ScorePreviewDTO, e.ApiError) {
+	raceProdes, newScores, apiErr := s.planRaceScores(ctx, sessionID, topDrivers)
+	if apiErr != nil {
+		return prodes.ScorePreviewDTO{}, apiErr
+	}
+
+	preview := prodes.ScorePreviewDTO{SessionID: sessionID, ProdesEvaluated: len(raceProdes)}
+	users := make(map[int]bool)
+	for _, prode := range raceProdes {
+		if delta := newScores[prode.ID] - prode.Score; delta != 0 {
+			preview.ProdesChanged++
+			preview.TotalDelta += delta
+			users[prode.UserID] = true
+		}
+	}
+	preview.UsersAffected = len(users)
+	return preview, nil
+}
+
+// PreviewSessionScores simula el recálculo de los prodes de sesión con una clasificación hipotética
+func (s *prodeService) PreviewSessionScores(ctx context.Context, sessionID int, topDrivers []prodes.TopDriverDTO) (prodes.ScorePreviewDTO, e.ApiError) {
+	prodesSession, err := s.prodeRepo.GetSessionProdesBySession(ctx, sessionID)
+	if err != nil {
+		return prodes.ScorePreviewDTO{}, e.NewInternalServerApiError("Error fetching prodes session for scoring", err)
+	}
+
+	preview := prodes.ScorePreviewDTO{SessionID: sessionID, ProdesEvaluated: len(prodesSession)}
+	users := make(map[int]bool)
+	for _, prode := range prodesSession {
+		if delta := calculateSessionScore(prode, topDrivers) - prode.Score; delta != 0 {
+			preview.ProdesChanged++
+			preview.TotalDelta += delta
+			users[prode.UserID] = true
+		}
+	}
+	preview.UsersAffected = len(users)
+	return preview, nil
+}
+
+func (s *prodeService) CreateRuleset(ctx context.Context, request prodes.CreateRulesetDTO) (prodes.ResponseRulesetDTO, e.ApiError) {
+	switch request.Mode {
+	case model.RulesetModeClassic:
+		request.ConfidenceBudget = 0
+		request.MaxPerPick = 0
+	case model.RulesetModeConfidence:
+		if request.ConfidenceBudget <= 0 {
+			return prodes.ResponseRulesetDTO{}, e.NewBadRequestApiError("El presupuesto de confianza debe ser mayor a 0")
+		}
+		if request.MaxPerPick < 0 || request.MaxPerPick > request.ConfidenceBudget {
+			return prodes.ResponseRulesetDTO{}, e.NewBadRequestApiError("El máximo por pick debe estar entre 0 y el presupuesto de confianza")
+		}
+	default:
+		return prodes.ResponseRulesetDTO{}, e.NewBadRequestApiError("Modo de ruleset inválido, debe ser 'classic' o 'confidence'")
+	}
+
+	ruleset := model.Ruleset{
+		Name:             request.Name,
+		Mode:             request.Mode,
+		ConfidenceBudget: request.ConfidenceBudget,
+		MaxPerPick:       request.MaxPerPick,
+		Active:           true,
+	}
+	if apiErr := s.prodeRepo.CreateRuleset(ctx, &ruleset); apiErr != nil {
+		return prodes.ResponseRulesetDTO{}, apiErr
+	}
+
+	return toResponseRulesetDTO(&ruleset), nil
+}
+
+func (s *prodeService) GetRulesets(ctx context.Context) ([]prodes.ResponseRulesetDTO, e.ApiError) {
+	rulesets, apiErr := s.prodeRepo.GetRulesets(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	response := make([]prodes.ResponseRulesetDTO, 0, len(rulesets))
+	for _, ruleset := range rulesets {
+		response = append(response, toResponseRulesetDTO(ruleset))
+	}
+	return response, nil
+}
+
+func toResponseRulesetDTO(ruleset *model.Ruleset) prodes.ResponseRulesetDTO {
+	return prodes.ResponseRulesetDTO{
+		ID:               ruleset.ID,
+		Name:             ruleset.Name,
+		Mode:             ruleset.Mode,
+		ConfidenceBudget: ruleset.ConfidenceBudget,
+		MaxPerPick:       ruleset.MaxPerPick,
+		Active:           ruleset.Active,
+	}
+}
+
 func calculateRaceScore(prode *model.ProdeCarrera, realTop []prodes.TopDriverDTO, realVSC bool, realSC bool, realDNF int) int {
 	score := 0
 
@@ -1017,6 +1232,40 @@ func calculateRaceScore(prode *model.ProdeCarrera, realTop []prodes.TopDriverDTO
 	return score
 }
 
+// calculateConfidenceRaceScore otorga a cada pick acertado los puntos de confianza que el usuario le asignó.
+// En modo confidence solo cuenta la posición exacta, no hay puntos parciales por estar en el top.
+func calculateConfidenceRaceScore(prode *model.ProdeCarrera, realTop []prodes.TopDriverDTO, realVSC bool, realSC bool, realDNF int) int {
+	score := 0
+
+	picks := []struct {
+		driverID   int
+		confidence int
+	}{
+		{prode.P1, prode.ConfidenceP1},
+		{prode.P2, prode.ConfidenceP2},
+		{prode.P3, prode.ConfidenceP3},
+		{prode.P4, prode.ConfidenceP4},
+		{prode.P5, prode.ConfidenceP5},
+	}
+	for i, pick := range picks {
+		if len(realTop) > i && pick.driverID == realTop[i].DriverID {
+			score += pick.confidence
+		}
+	}
+
+	if prode.VSC == realVSC {
+		score += prode.ConfidenceVSC
+	}
+	if prode.SC == realSC {
+		score += prode.ConfidenceSC
+	}
+	if prode.DNF == realDNF {
+		score += prode.ConfidenceDNF
+	}
+
+	return score
+}
+
 func calculateSessionScore(prode *model.ProdeSession, realTop []prodes.TopDriverDTO) int {
 	score := 0
 
@@ -1059,6 +1308,113 @@ func driverInList(driverID int, realTop []prodes.TopDriverDTO) bool {
 	return false
 }
 
+// isConfidenceProde indica si el prode se juega con un ruleset en modo confidence.
+// Los rulesets consultados se guardan en cache para reutilizarlos dentro del mismo recálculo.
+func (s *prodeService) isConfidenceProde(ctx context.Context, prode *model.ProdeCarrera, cache map[int]*model.Ruleset) bool {
+	if prode.RulesetID == nil {
+		return false
+	}
+	ruleset, ok := cache[*prode.RulesetID]
+	if !ok {
+		var apiErr e.ApiError
+		ruleset, apiErr = s.prodeRepo.GetRulesetByID(ctx, *prode.RulesetID)
+		if apiErr != nil {
+			log.Printf("Error fetching ruleset %d, se puntúa como clásico: %v", *prode.RulesetID, apiErr)
+			ruleset = nil
+		}
+		cache[*prode.RulesetID] = ruleset
+	}
+	return ruleset != nil && ruleset.Mode == model.RulesetModeConfidence
+}
+
+// validateConfidenceAllocation verifica que, si el ruleset es de confianza, el presupuesto se reparta completo
+// y sin valores negativos ni por encima del máximo por pick.
+func (s *prodeService) validateConfidenceAllocation(ctx context.Context, rulesetID *int, allocation *prodes.ConfidenceAllocationDTO) e.ApiError {
+	if rulesetID == nil {
+		if allocation != nil {
+			return e.NewBadRequestApiError("Se enviaron puntos de confianza pero el pronóstico no tiene un ruleset asociado")
+		}
+		return nil
+	}
+
+	ruleset, apiErr := s.prodeRepo.GetRulesetByID(ctx, *rulesetID)
+	if apiErr != nil {
+		return apiErr
+	}
+	if !ruleset.Active {
+		return e.NewBadRequestApiError(fmt.Sprintf("El ruleset %s no está activo", ruleset.Name))
+	}
+
+	if ruleset.Mode != model.RulesetModeConfidence {
+		if allocation != nil {
+			return e.NewBadRequestApiError(fmt.Sprintf("El ruleset %s no admite puntos de confianza", ruleset.Name))
+		}
+		return nil
+	}
+
+	if allocation == nil {
+		return e.NewBadRequestApiError(fmt.Sprintf("El ruleset %s requiere repartir %d puntos de confianza", ruleset.Name, ruleset.ConfidenceBudget))
+	}
+
+	// Slice y no map: con varios picks inválidos el error siempre reporta el primero en el mismo orden
+	values := []struct {
+		pick  string
+		value int
+	}{
+		{"p1", allocation.P1}, {"p2", allocation.P2}, {"p3", allocation.P3}, {"p4", allocation.P4}, {"p5", allocation.P5},
+		{"vsc", allocation.VSC}, {"sc", allocation.SC}, {"dnf", allocation.DNF},
+	}
+	total := 0
+	for _, v := range values {
+		pick, value := v.pick, v.value
+		if value < 0 {
+			return e.NewBadRequestApiError(fmt.Sprintf("Los puntos de confianza de %s no pueden ser negativos", pick))
+		}
+		if ruleset.MaxPerPick > 0 && value > ruleset.MaxPerPick {
+			return e.NewBadRequestApiError(fmt.Sprintf("Los puntos de confianza de %s superan el máximo por pick (%d)", pick, ruleset.MaxPerPick))
+		}
+		total += value
+	}
+	if total != ruleset.ConfidenceBudget {
+		return e.NewBadRequestApiError(fmt.Sprintf("Se deben repartir exactamente %d puntos de confianza (se asignaron %d)", ruleset.ConfidenceBudget, total))
+	}
+
+	return nil
+}
+
+// applyConfidenceAllocation copia el reparto de confianza al modelo (todo en 0 si no hay reparto)
+func applyConfidenceAllocation(prode *model.ProdeCarrera, allocation *prodes.ConfidenceAllocationDTO) {
+	if allocation == nil {
+		allocation = &prodes.ConfidenceAllocationDTO{}
+	}
+	prode.ConfidenceP1 = allocation.P1
+	prode.ConfidenceP2 = allocation.P2
+	prode.ConfidenceP3 = allocation.P3
+	prode.ConfidenceP4 = allocation.P4
+	prode.ConfidenceP5 = allocation.P5
+	prode.ConfidenceVSC = allocation.VSC
+	prode.ConfidenceSC = allocation.SC
+	prode.ConfidenceDNF = allocation.DNF
+}
+
+// confidenceAllocationFromModel arma el DTO de confianza; nil si el prode no se juega con un ruleset de confianza.
+// rulesets cachea los rulesets ya consultados cuando se arman varias respuestas.
+func (s *prodeService) confidenceAllocationFromModel(ctx context.Context, prode *model.ProdeCarrera, rulesets map[int]*model.Ruleset) *prodes.ConfidenceAllocationDTO {
+	if !s.isConfidenceProde(ctx, prode, rulesets) {
+		return nil
+	}
+	return &prodes.ConfidenceAllocationDTO{
+		P1:  prode.ConfidenceP1,
+		P2:  prode.ConfidenceP2,
+		P3:  prode.ConfidenceP3,
+		P4:  prode.ConfidenceP4,
+		P5:  prode.ConfidenceP5,
+		VSC: prode.ConfidenceVSC,
+		SC:  prode.ConfidenceSC,
+		DNF: prode.ConfidenceDNF,
+	}
+}
+
 func isRaceSession(sessionName string, sessionType string) bool {
 	return sessionName == "Race" && sessionType == "Race"
 }
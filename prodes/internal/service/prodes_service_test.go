@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+	"testing"
+
+	"prediapp.local/db/model"
+	prodes "prediapp.local/prodes/internal/dto"
+	"prediapp.local/prodes/internal/repository"
+	e "prediapp.local/prodes/pkg/utils"
+)
+
+// rulesetRepository es un ProdeRepository que solo resuelve rulesets desde un mapa en memoria
+type rulesetRepository struct {
+	repository.ProdeRepository
+	rulesets map[int]*model.Ruleset
+	calls    int
+}
+
+func (r *rulesetRepository) GetRulesetByID(ctx context.Context, rulesetID int) (*model.Ruleset, e.ApiError) {
+	r.calls++
+	ruleset, ok := r.rulesets[rulesetID]
+	if !ok {
+		return nil, e.NewNotFoundApiError("ruleset not found")
+	}
+	return ruleset, nil
+}
+
+func newRulesetRepository() *rulesetRepository {
+	return &rulesetRepository{rulesets: map[int]*model.Ruleset{
+		1: {ID: 1, Name: "clásico", Mode: model.RulesetModeClassic, Active: true},
+		2: {ID: 2, Name: "confianza", Mode: model.RulesetModeConfidence, ConfidenceBudget: 10, MaxPerPick: 4, Active: true},
+		3: {ID: 3, Name: "confianza vieja", Mode: model.RulesetModeConfidence, ConfidenceBudget: 10, Active: false},
+		4: {ID: 4, Name: "confianza libre", Mode: model.RulesetModeConfidence, ConfidenceBudget: 10, Active: true},
+	}}
+}
+
+func TestMain(m *testing.M) {
+	// El servicio loguea los rulesets que no encuentra; se silencia para no ensuciar la salida
+	log.SetOutput(io.Discard)
+	os.Exit(m.Run())
+}
+
+func TestCalculateConfidenceRaceScore(t *testing.T) {
+	realTop := []prodes.TopDriverDTO{
+		{Position: 1, DriverID: 10}, {Position: 2, DriverID: 20}, {Position: 3, DriverID: 30},
+		{Position: 4, DriverID: 40}, {Position: 5, DriverID: 50},
+	}
+	prode := func(p1, p2, p3, p4, p5 int, vsc, sc bool, dnf int) *model.ProdeCarrera {
+		return &model.ProdeCarrera{
+			P1: p1, P2: p2, P3: p3, P4: p4, P5: p5, VSC: vsc, SC: sc, DNF: dnf,
+			ConfidenceP1: 3, ConfidenceP2: 1, ConfidenceP3: 1, ConfidenceP4: 0, ConfidenceP5: 1,
+			ConfidenceVSC: 1, ConfidenceSC: 2, ConfidenceDNF: 1,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		prode   *model.ProdeCarrera
+		realTop []prodes.TopDriverDTO
+		want    int
+	}{
+		{"todo acertado suma el presupuesto", prode(10, 20, 30, 40, 50, true, false, 2), realTop, 10},
+		{"nada acertado", prode(50, 40, 10, 20, 30, false, true, 0), realTop, 0},
+		{"piloto en el top pero fuera de posición no suma", prode(20, 10, 30, 40, 50, false, true, 0), realTop, 2},
+		{"solo VSC, SC y DNF", prode(1, 2, 3, 4, 5, true, false, 2), realTop, 4},
+		{"top incompleto", prode(10, 20, 30, 40, 50, false, true, 0), realTop[:2], 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calculateConfidenceRaceScore(tt.prode, tt.realTop, true, false, 2); got != tt.want {
+				t.Errorf("calculateConfidenceRaceScore() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateConfidenceAllocation(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	valid := &prodes.ConfidenceAllocationDTO{P1: 4, P2: 2, P3: 1, VSC: 1, SC: 1, DNF: 1}
+
+	tests := []struct {
+		name       string
+		rulesetID  *int
+		allocation *prodes.ConfidenceAllocationDTO
+		wantErr    bool
+	}{
+		{"sin ruleset ni reparto", nil, nil, false},
+		{"reparto sin ruleset", nil, valid, true},
+		{"ruleset inexistente", intPtr(99), nil, true},
+		{"ruleset inactivo", intPtr(3), valid, true},
+		{"clásico sin reparto", intPtr(1), nil, false},
+		{"clásico con reparto", intPtr(1), valid, true},
+		{"confianza sin reparto", intPtr(2), nil, true},
+		{"confianza con el presupuesto justo", intPtr(2), valid, false},
+		{"presupuesto incompleto", intPtr(2), &prodes.ConfidenceAllocationDTO{P1: 4, P2: 4}, true},
+		{"presupuesto excedido", intPtr(2), &prodes.ConfidenceAllocationDTO{P1: 4, P2: 4, P3: 4}, true},
+		{"valor negativo", intPtr(2), &prodes.ConfidenceAllocationDTO{P1: 4, P2: 4, P3: 4, DNF: -2}, true},
+		{"supera el máximo por pick", intPtr(2), &prodes.ConfidenceAllocationDTO{P1: 5, P2: 5}, true},
+		{"sin máximo por pick", intPtr(4), &prodes.ConfidenceAllocationDTO{P1: 10}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &prodeService{prodeRepo: newRulesetRepository()}
+			apiErr := s.validateConfidenceAllocation(context.Background(), tt.rulesetID, tt.allocation)
+			if (apiErr != nil) != tt.wantErr {
+				t.Errorf("validateConfidenceAllocation() error = %v, wantErr %v", apiErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfidenceAllocationFromModel(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name      string
+		rulesetID *int
+		wantNil   bool
+	}{
+		{"sin ruleset", nil, true},
+		{"ruleset clásico", intPtr(1), true},
+		{"ruleset inexistente", intPtr(99), true},
+		{"ruleset de confianza", intPtr(2), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &prodeService{prodeRepo: newRulesetRepository()}
+			prode := &model.ProdeCarrera{RulesetID: tt.rulesetID, ConfidenceP1: 6, ConfidenceDNF: 4}
+			got := s.confidenceAllocationFromModel(context.Background(), prode, map[int]*model.Ruleset{})
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("confidenceAllocationFromModel() = %+v, wantNil %v", got, tt.wantNil)
+			}
+			if got != nil && (got.P1 != 6 || got.DNF != 4) {
+				t.Errorf("confidenceAllocationFromModel() = %+v, want P1 6 y DNF 4", got)
+			}
+		})
+	}
+}
+
+func TestIsConfidenceProdeCachesRulesets(t *testing.T) {
+	repo := newRulesetRepository()
+	s := &prodeService{prodeRepo: repo}
+	cache := map[int]*model.Ruleset{}
+	confidence, missing := 2, 99
+
+	for i := 0; i < 3; i++ {
+		if !s.isConfidenceProde(context.Background(), &model.ProdeCarrera{RulesetID: &confidence}, cache) {
+			t.Fatalf("el ruleset %d es de confianza", confidence)
+		}
+		if s.isConfidenceProde(context.Background(), &model.ProdeCarrera{RulesetID: &missing}, cache) {
+			t.Fatalf("un ruleset inexistente se puntúa como clásico")
+		}
+	}
+	if repo.calls != 2 {
+		t.Errorf("GetRulesetByID se llamó %d veces, want 2", repo.calls)
+	}
+}
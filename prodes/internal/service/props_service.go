@@ -0,0 +1,401 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	model "prediapp.local/db/model"
+	prodes "prediapp.local/prodes/internal/dto"
+	e "prediapp.local/prodes/pkg/utils"
+)
+
+func (s *prodeService) GetProps(ctx context.Context) ([]prodes.ResponsePropDTO, e.ApiError) {
+	props, apiErr := s.prodeRepo.GetActiveProps(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	response := make([]prodes.ResponsePropDTO, 0, len(props))
+	for _, prop := range props {
+		response = append(response, toResponsePropDTO(prop))
+	}
+	return response, nil
+}
+
+// CreateProp crea un prop nuevo sin tocar el esquema. Si no tiene resolver automático en results,
+// su valor real se carga a mano con PUT /results/session/:sessionID/props/:propID.
+func (s *prodeService) CreateProp(ctx context.Context, request prodes.CreatePropDTO) (prodes.ResponsePropDTO, e.ApiError) {
+	code := normalizePropAnswer(request.Code)
+	switch request.AnswerType {
+	case model.PropAnswerBoolean, model.PropAnswerDriver:
+		if len(request.BucketBounds) > 0 {
+			return prodes.ResponsePropDTO{}, e.NewBadRequestApiError("Solo los props numéricos (integer o number) pueden tener rangos")
+		}
+	case model.PropAnswerInteger, model.PropAnswerNumber:
+	default:
+		return prodes.ResponsePropDTO{}, e.NewBadRequestApiError("Tipo de respuesta inválido, debe ser boolean, integer, number o driver")
+	}
+	if request.Points < 0 {
+		return prodes.ResponsePropDTO{}, e.NewBadRequestApiError("Los puntos del prop no pueden ser negativos")
+	}
+	buckets, apiErr := formatPropBuckets(request.AnswerType, request.BucketBounds)
+	if apiErr != nil {
+		return prodes.ResponsePropDTO{}, apiErr
+	}
+
+	existing, apiErr := s.prodeRepo.GetPropByCode(ctx, code)
+	if apiErr != nil {
+		return prodes.ResponsePropDTO{}, apiErr
+	}
+	if existing != nil {
+		return prodes.ResponsePropDTO{}, e.NewBadRequestApiError(fmt.Sprintf("Ya existe un prop con el código %s", code))
+	}
+
+	prop := &model.Prop{
+		Code:        code,
+		Name:        request.Name,
+		Description: request.Description,
+		AnswerType:  request.AnswerType,
+		Points:      request.Points,
+		Buckets:     buckets,
+		Active:      true,
+	}
+	if apiErr := s.prodeRepo.CreateProp(ctx, prop); apiErr != nil {
+		return prodes.ResponsePropDTO{}, apiErr
+	}
+	return toResponsePropDTO(prop), nil
+}
+
+// UpdateProp actualiza nombre, puntos, rangos o estado de un prop
+func (s *prodeService) UpdateProp(ctx context.Context, propID int, request prodes.UpdatePropDTO) (prodes.ResponsePropDTO, e.ApiError) {
+	prop, apiErr := s.prodeRepo.GetPropByID(ctx, propID)
+	if apiErr != nil {
+		return prodes.ResponsePropDTO{}, apiErr
+	}
+
+	if request.Name != nil {
+		if strings.TrimSpace(*request.Name) == "" {
+			return prodes.ResponsePropDTO{}, e.NewBadRequestApiError("El nombre del prop no puede estar vacío")
+		}
+		prop.Name = *request.Name
+	}
+	if request.Description != nil {
+		prop.Description = *request.Description
+	}
+	if request.Points != nil {
+		if *request.Points < 0 {
+			return prodes.ResponsePropDTO{}, e.NewBadRequestApiError("Los puntos del prop no pueden ser negativos")
+		}
+		prop.Points = *request.Points
+	}
+	if request.BucketBounds != nil {
+		if prop.AnswerType != model.PropAnswerInteger && prop.AnswerType != model.PropAnswerNumber && len(request.BucketBounds) > 0 {
+			return prodes.ResponsePropDTO{}, e.NewBadRequestApiError("Solo los props numéricos (integer o number) pueden tener rangos")
+		}
+		buckets, apiErr := formatPropBuckets(prop.AnswerType, request.BucketBounds)
+		if apiErr != nil {
+			return prodes.ResponsePropDTO{}, apiErr
+		}
+		prop.Buckets = buckets
+	}
+	if request.Active != nil {
+		prop.Active = *request.Active
+	}
+
+	if apiErr := s.prodeRepo.UpdateProp(ctx, prop); apiErr != nil {
+		return prodes.ResponsePropDTO{}, apiErr
+	}
+	return toResponsePropDTO(prop), nil
+}
+
+// DeleteProp desactiva el prop: deja de ofrecerse y de puntuar, pero se conservan las respuestas ya guardadas
+func (s *prodeService) DeleteProp(ctx context.Context, propID int) e.ApiError {
+	prop, apiErr := s.prodeRepo.GetPropByID(ctx, propID)
+	if apiErr != nil {
+		return apiErr
+	}
+	prop.Active = false
+	return s.prodeRepo.UpdateProp(ctx, prop)
+}
+
+// validatePropAnswers verifica que cada respuesta apunte a un prop activo, sin repetir, y que su valor respete el tipo del prop
+func (s *prodeService) validatePropAnswers(ctx context.Context, answers []prodes.PropAnswerDTO) e.ApiError {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	props, apiErr := s.prodeRepo.GetActiveProps(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	propsByID := make(map[int]*model.Prop)
+	for _, prop := range props {
+		propsByID[prop.ID] = prop
+	}
+
+	seen := make(map[int]bool)
+	for _, answer := range answers {
+		prop, ok := propsByID[answer.PropID]
+		if !ok {
+			return e.NewBadRequestApiError(fmt.Sprintf("El prop %d no existe o no está activo", answer.PropID))
+		}
+		if seen[answer.PropID] {
+			return e.NewBadRequestApiError(fmt.Sprintf("El prop %s está respondido más de una vez", prop.Code))
+		}
+		seen[answer.PropID] = true
+
+		if !validPropAnswer(prop, answer.Answer) {
+			if prop.Buckets != "" {
+				return e.NewBadRequestApiError(fmt.Sprintf("Respuesta inválida para el prop %s: se esperaba uno de los rangos %s", prop.Code, strings.Join(propBucketLabels(prop), ", ")))
+			}
+			return e.NewBadRequestApiError(fmt.Sprintf("Respuesta inválida para el prop %s: se esperaba un valor de tipo %s", prop.Code, prop.AnswerType))
+		}
+	}
+	return nil
+}
+
+// saveProdeCarreraProps reemplaza las respuestas a props de un prode. Si answers es nil no se toca nada.
+func (s *prodeService) saveProdeCarreraProps(ctx context.Context, prodeID int, answers []prodes.PropAnswerDTO) e.ApiError {
+	if answers == nil {
+		return nil
+	}
+
+	props := make([]*model.ProdeCarreraProp, 0, len(answers))
+	for _, answer := range answers {
+		props = append(props, &model.ProdeCarreraProp{
+			PropID: answer.PropID,
+			Answer: normalizePropAnswer(answer.Answer),
+		})
+	}
+	return s.prodeRepo.ReplaceProdeCarreraProps(ctx, prodeID, props)
+}
+
+// getPropAnswers arma las respuestas a props de un prode para el DTO de respuesta
+func (s *prodeService) getPropAnswers(ctx context.Context, prodeID int) []prodes.PropAnswerDTO {
+	props, apiErr := s.prodeRepo.GetProdeCarreraProps(ctx, prodeID)
+	if apiErr != nil {
+		fmt.Printf("Error fetching props for prode %d: %v\n", prodeID, apiErr)
+		return nil
+	}
+
+	var answers []prodes.PropAnswerDTO
+	for _, p := range props {
+		answers = append(answers, prodes.PropAnswerDTO{PropID: p.PropID, Answer: p.Answer, Score: p.Score})
+	}
+	return answers
+}
+
+// scoreRaceProps puntúa las respuestas a props de todos los prodes de la sesión contra los valores reales
+// y devuelve el puntaje total de props por prode. Si la sesión todavía no tiene props resueltos no suma nada.
+func (s *prodeService) scoreRaceProps(ctx context.Context, sessionID int) (map[int]int, e.ApiError) {
+	scoreByProde := make(map[int]int)
+
+	propResults, apiErr := s.prodeRepo.GetSessionPropResults(ctx, sessionID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if len(propResults) == 0 {
+		return scoreByProde, nil
+	}
+	realAnswers := make(map[int]string)
+	for _, pr := range propResults {
+		realAnswers[pr.PropID] = pr.Answer
+	}
+
+	props, apiErr := s.prodeRepo.GetActiveProps(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	propsByID := make(map[int]*model.Prop)
+	for _, prop := range props {
+		propsByID[prop.ID] = prop
+	}
+
+	answers, apiErr := s.prodeRepo.GetProdeCarreraPropsBySession(ctx, sessionID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	for _, answer := range answers {
+		newScore := 0
+		prop, ok := propsByID[answer.PropID]
+		realAnswer, resolved := realAnswers[answer.PropID]
+		if ok && resolved {
+			newScore = calculatePropScore(prop, answer.Answer, realAnswer)
+		}
+
+		if newScore != answer.Score {
+			if apiErr := s.prodeRepo.UpdateProdeCarreraPropScore(ctx, answer.ID, newScore); apiErr != nil {
+				return nil, apiErr
+			}
+		}
+		scoreByProde[answer.ProdeCarreraID] += newScore
+	}
+
+	return scoreByProde, nil
+}
+
+// calculatePropScore otorga los puntos del prop si la respuesta coincide con el valor real.
+// Las respuestas numéricas de un prop con rangos puntúan si caen en el mismo rango que el valor real;
+// sin rangos tienen que coincidir exactamente.
+func calculatePropScore(prop *model.Prop, answer string, realAnswer string) int {
+	switch prop.AnswerType {
+	case model.PropAnswerInteger, model.PropAnswerNumber:
+		if prop.Buckets != "" {
+			buckets := parsePropBuckets(prop)
+			predicted, ok1 := answerBucket(buckets, answer)
+			real, ok2 := answerBucket(buckets, realAnswer)
+			if ok1 && ok2 && predicted == real {
+				return prop.Points
+			}
+			return 0
+		}
+		predicted, err1 := strconv.ParseFloat(normalizePropAnswer(answer), 64)
+		real, err2 := strconv.ParseFloat(normalizePropAnswer(realAnswer), 64)
+		if err1 == nil && err2 == nil && predicted == real {
+			return prop.Points
+		}
+	default:
+		if normalizePropAnswer(answer) == normalizePropAnswer(realAnswer) {
+			return prop.Points
+		}
+	}
+	return 0
+}
+
+func validPropAnswer(prop *model.Prop, answer string) bool {
+	answer = normalizePropAnswer(answer)
+	switch prop.AnswerType {
+	case model.PropAnswerBoolean:
+		return answer == "true" || answer == "false"
+	case model.PropAnswerInteger, model.PropAnswerNumber:
+		if prop.Buckets != "" {
+			_, ok := answerBucket(parsePropBuckets(prop), answer)
+			return ok
+		}
+		if prop.AnswerType == model.PropAnswerInteger {
+			n, err := strconv.Atoi(answer)
+			return err == nil && n >= 0
+		}
+		f, err := strconv.ParseFloat(answer, 64)
+		return err == nil && f >= 0
+	case model.PropAnswerDriver:
+		n, err := strconv.Atoi(answer)
+		return err == nil && n >= 0
+	}
+	return false
+}
+
+func normalizePropAnswer(answer string) string {
+	return strings.ToLower(strings.TrimSpace(answer))
+}
+
+// propBucket es un rango [Min, Max) de respuestas numéricas; el último rango no tiene máximo
+type propBucket struct {
+	Min   float64
+	Max   *float64
+	Label string
+}
+
+// parsePropBuckets arma los rangos a partir de los límites guardados en el prop ("0,20,25,30").
+// Los enteros se rotulan con el rango cerrado ("20-24"), los decimales con los límites ("1-3"), y el último con "+".
+func parsePropBuckets(prop *model.Prop) []propBucket {
+	var bounds []float64
+	for _, part := range strings.Split(prop.Buckets, ",") {
+		bound, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			continue
+		}
+		bounds = append(bounds, bound)
+	}
+
+	buckets := make([]propBucket, 0, len(bounds))
+	for i, bound := range bounds {
+		bucket := propBucket{Min: bound, Label: formatBound(bound) + "+"}
+		if i+1 < len(bounds) {
+			next := bounds[i+1]
+			bucket.Max = &next
+			switch {
+			case prop.AnswerType == model.PropAnswerInteger && next-bound == 1:
+				bucket.Label = formatBound(bound)
+			case prop.AnswerType == model.PropAnswerInteger:
+				bucket.Label = formatBound(bound) + "-" + formatBound(next-1)
+			default:
+				bucket.Label = formatBound(bound) + "-" + formatBound(next)
+			}
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// answerBucket devuelve el índice del rango de una respuesta: acepta el rótulo del rango o un valor numérico
+func answerBucket(buckets []propBucket, answer string) (int, bool) {
+	answer = normalizePropAnswer(answer)
+	for i, bucket := range buckets {
+		if bucket.Label == answer {
+			return i, true
+		}
+	}
+	value, err := strconv.ParseFloat(answer, 64)
+	if err != nil {
+		return 0, false
+	}
+	for i, bucket := range buckets {
+		if value >= bucket.Min && (bucket.Max == nil || value < *bucket.Max) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func propBucketLabels(prop *model.Prop) []string {
+	buckets := parsePropBuckets(prop)
+	labels := make([]string, 0, len(buckets))
+	for _, bucket := range buckets {
+		labels = append(labels, bucket.Label)
+	}
+	return labels
+}
+
+// formatPropBuckets valida los límites de los rangos (crecientes, no negativos, enteros en props integer) y los serializa
+func formatPropBuckets(answerType string, bounds []float64) (string, e.ApiError) {
+	parts := make([]string, 0, len(bounds))
+	for i, bound := range bounds {
+		if bound < 0 {
+			return "", e.NewBadRequestApiError("Los límites de los rangos no pueden ser negativos")
+		}
+		if i > 0 && bound <= bounds[i-1] {
+			return "", e.NewBadRequestApiError("Los límites de los rangos deben ser crecientes")
+		}
+		if answerType == model.PropAnswerInteger && bound != math.Trunc(bound) {
+			return "", e.NewBadRequestApiError("Los límites de los rangos de un prop integer deben ser enteros")
+		}
+		parts = append(parts, formatBound(bound))
+	}
+	return strings.Join(parts, ","), nil
+}
+
+func formatBound(bound float64) string {
+	return strconv.FormatFloat(bound, 'f', -1, 64)
+}
+
+func toResponsePropDTO(prop *model.Prop) prodes.ResponsePropDTO {
+	response := prodes.ResponsePropDTO{
+		ID:          prop.ID,
+		Code:        prop.Code,
+		Name:        prop.Name,
+		Description: prop.Description,
+		AnswerType:  prop.AnswerType,
+		Points:      prop.Points,
+		Active:      prop.Active,
+	}
+	for _, bucket := range parsePropBuckets(prop) {
+		response.BucketBounds = append(response.BucketBounds, bucket.Min)
+		response.Buckets = append(response.Buckets, bucket.Label)
+	}
+	return response
+}
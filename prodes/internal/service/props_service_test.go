@@ -0,0 +1,94 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"prediapp.local/db/model"
+)
+
+func TestCalculatePropScore(t *testing.T) {
+	margin := &model.Prop{AnswerType: model.PropAnswerNumber, Points: 5, Buckets: "0,1,3,10"}
+	pitStops := &model.Prop{AnswerType: model.PropAnswerInteger, Points: 3, Buckets: "0,20,25,30"}
+	exact := &model.Prop{AnswerType: model.PropAnswerInteger, Points: 2}
+	redFlag := &model.Prop{AnswerType: model.PropAnswerBoolean, Points: 4}
+
+	tests := []struct {
+		name   string
+		prop   *model.Prop
+		answer string
+		real   string
+		want   int
+	}{
+		{"rango decimal por rótulo", margin, "1-3", "2.417", 5},
+		{"rango decimal por valor", margin, "1.2", "2.999", 5},
+		{"límite inferior entra en el rango", margin, "3", "3.000", 5},
+		{"límite superior pasa al rango siguiente", margin, "1-3", "3", 0},
+		{"último rango abierto", margin, "10+", "14.5", 5},
+		{"rango entero cerrado", pitStops, "20-24", "24", 3},
+		{"rango entero distinto", pitStops, "20-24", "25", 0},
+		{"último rango entero", pitStops, "30+", "41", 3},
+		{"valor real fuera de los rangos", pitStops, "0-19", "abc", 0},
+		{"sin rangos coincide exacto", exact, "2", "2", 2},
+		{"sin rangos no coincide", exact, "2", "3", 0},
+		{"booleano ignora mayúsculas y espacios", redFlag, " TRUE ", "true", 4},
+		{"booleano distinto", redFlag, "false", "true", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calculatePropScore(tt.prop, tt.answer, tt.real); got != tt.want {
+				t.Errorf("calculatePropScore(%q, %q) = %d, want %d", tt.answer, tt.real, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePropBucketsLabels(t *testing.T) {
+	tests := []struct {
+		name string
+		prop *model.Prop
+		want []string
+	}{
+		{"enteros", &model.Prop{AnswerType: model.PropAnswerInteger, Buckets: "0,20,25,30"}, []string{"0-19", "20-24", "25-29", "30+"}},
+		{"enteros de a uno", &model.Prop{AnswerType: model.PropAnswerInteger, Buckets: "0,1,2"}, []string{"0", "1", "2+"}},
+		{"decimales", &model.Prop{AnswerType: model.PropAnswerNumber, Buckets: "0,0.5,2"}, []string{"0-0.5", "0.5-2", "2+"}},
+		{"sin rangos", &model.Prop{AnswerType: model.PropAnswerNumber}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := propBucketLabels(tt.prop); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("propBucketLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatPropBuckets(t *testing.T) {
+	tests := []struct {
+		name       string
+		answerType string
+		bounds     []float64
+		want       string
+		wantErr    bool
+	}{
+		{"enteros", model.PropAnswerInteger, []float64{0, 20, 25}, "0,20,25", false},
+		{"decimales", model.PropAnswerNumber, []float64{0, 0.5, 2}, "0,0.5,2", false},
+		{"negativo", model.PropAnswerNumber, []float64{-1, 2}, "", true},
+		{"no creciente", model.PropAnswerNumber, []float64{0, 2, 2}, "", true},
+		{"decimal en prop integer", model.PropAnswerInteger, []float64{0, 1.5}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, apiErr := formatPropBuckets(tt.answerType, tt.bounds)
+			if (apiErr != nil) != tt.wantErr {
+				t.Fatalf("formatPropBuckets() error = %v, wantErr %v", apiErr, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("formatPropBuckets() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
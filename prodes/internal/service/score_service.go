@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	prodes "prediapp.local/prodes/internal/dto"
+	repository "prediapp.local/prodes/internal/repository"
+	e "prediapp.local/prodes/pkg/utils"
+)
+
+type scoreService struct {
+	scoreRepo repository.ScoreRepository
+}
+
+type ScoreServiceInterface interface {
+	ReconcileScores(ctx context.Context, fix bool) (prodes.ScoreReconciliationReportDTO, e.ApiError)
+	StartReconciliationJob(ctx context.Context, interval time.Duration, fix bool)
+}
+
+// NewScoreService crea el servicio de reconciliación de puntajes
+func NewScoreService(scoreRepo repository.ScoreRepository) ScoreServiceInterface {
+	return &scoreService{scoreRepo: scoreRepo}
+}
+
+// ReconcileScores recalcula el puntaje de cada usuario desde sus prodes, informa las diferencias
+// con users.score y user_season_scores y, si fix es true, las corrige.
+func (s *scoreService) ReconcileScores(ctx context.Context, fix bool) (prodes.ScoreReconciliationReportDTO, e.ApiError) {
+	// 1. Recalcular totales históricos y por temporada
+	users, apiErr := s.scoreRepo.GetUserScoreTotals(ctx)
+	if apiErr != nil {
+		return prodes.ScoreReconciliationReportDTO{}, apiErr
+	}
+	seasons, apiErr := s.scoreRepo.GetSeasonScoreTotals(ctx)
+	if apiErr != nil {
+		return prodes.ScoreReconciliationReportDTO{}, apiErr
+	}
+
+	report := prodes.ScoreReconciliationReportDTO{
+		CheckedAt:      time.Now(),
+		UsersChecked:   len(users),
+		SeasonsChecked: len(seasons),
+		Discrepancies:  []prodes.ScoreDiscrepancyDTO{},
+	}
+
+	// 2. Detectar diferencias
+	usernames := make(map[int]string, len(users))
+	var driftedUsers []repository.UserScoreTotal
+	for _, u := range users {
+		usernames[u.UserID] = u.Username
+		if u.StoredScore == u.ComputedScore {
+			continue
+		}
+		diff := u.StoredScore - u.ComputedScore
+		driftedUsers = append(driftedUsers, u)
+		report.UserDiscrepancies++
+		report.TotalDrift += absInt(diff)
+		report.Discrepancies = append(report.Discrepancies, prodes.ScoreDiscrepancyDTO{
+			UserID:        u.UserID,
+			Username:      u.Username,
+			StoredScore:   u.StoredScore,
+			ComputedScore: u.ComputedScore,
+			Difference:    diff,
+		})
+	}
+
+	var driftedSeasons []repository.SeasonScoreTotal
+	for _, st := range seasons {
+		if st.StoredScore == st.ComputedScore {
+			continue
+		}
+		year := st.Year
+		driftedSeasons = append(driftedSeasons, st)
+		report.SeasonDiscrepancies++
+		report.Discrepancies = append(report.Discrepancies, prodes.ScoreDiscrepancyDTO{
+			UserID:        st.UserID,
+			Username:      usernames[st.UserID],
+			Season:        &year,
+			StoredScore:   st.StoredScore,
+			ComputedScore: st.ComputedScore,
+			Difference:    st.StoredScore - st.ComputedScore,
+			Archived:      st.Archived,
+		})
+	}
+
+	// 3. Corregir si se pidió
+	if fix && (len(driftedUsers) > 0 || len(driftedSeasons) > 0) {
+		if apiErr := s.scoreRepo.FixScores(ctx, driftedUsers, driftedSeasons); apiErr != nil {
+			return prodes.ScoreReconciliationReportDTO{}, apiErr
+		}
+		report.Fixed = true
+	}
+
+	return report, nil
+}
+
+// StartReconciliationJob corre la reconciliación periódicamente y loguea las diferencias encontradas
+func (s *scoreService) StartReconciliationJob(ctx context.Context, interval time.Duration, fix bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, apiErr := s.ReconcileScores(ctx, fix)
+			if apiErr != nil {
+				log.Printf("Score reconciliation job: error: %v", apiErr)
+				continue
+			}
+			if report.UserDiscrepancies > 0 || report.SeasonDiscrepancies > 0 {
+				log.Printf("Score reconciliation job: %d usuarios y %d temporadas con diferencias (drift total %d, corregido: %t)",
+					report.UserDiscrepancies, report.SeasonDiscrepancies, report.TotalDrift, report.Fixed)
+			}
+		}
+	}
+}
+
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"prediapp.local/prodes/internal/repository"
+	e "prediapp.local/prodes/pkg/utils"
+)
+
+// scoreTotalsRepository devuelve totales fijos y registra lo que se manda a corregir
+type scoreTotalsRepository struct {
+	users        []repository.UserScoreTotal
+	seasons      []repository.SeasonScoreTotal
+	fixedUsers   []repository.UserScoreTotal
+	fixedSeasons []repository.SeasonScoreTotal
+	fixCalls     int
+}
+
+func (r *scoreTotalsRepository) GetUserScoreTotals(ctx context.Context) ([]repository.UserScoreTotal, e.ApiError) {
+	return r.users, nil
+}
+
+func (r *scoreTotalsRepository) GetSeasonScoreTotals(ctx context.Context) ([]repository.SeasonScoreTotal, e.ApiError) {
+	return r.seasons, nil
+}
+
+func (r *scoreTotalsRepository) FixScores(ctx context.Context, users []repository.UserScoreTotal, seasons []repository.SeasonScoreTotal) e.ApiError {
+	r.fixCalls++
+	r.fixedUsers, r.fixedSeasons = users, seasons
+	return nil
+}
+
+func TestReconcileScores(t *testing.T) {
+	consistentUsers := []repository.UserScoreTotal{
+		{UserID: 1, Username: "ana", StoredScore: 40, ComputedScore: 40},
+		{UserID: 2, Username: "beto", StoredScore: 12, ComputedScore: 12},
+	}
+	consistentSeasons := []repository.SeasonScoreTotal{
+		{UserID: 1, Year: 2024, StoredScore: 40, ComputedScore: 40},
+		{UserID: 2, Year: 2024, StoredScore: 12, ComputedScore: 12},
+	}
+	driftedUsers := []repository.UserScoreTotal{
+		{UserID: 1, Username: "ana", StoredScore: 45, ComputedScore: 40},
+		{UserID: 2, Username: "beto", StoredScore: 9, ComputedScore: 12},
+		{UserID: 3, Username: "caro", StoredScore: 7, ComputedScore: 7},
+	}
+	driftedSeasons := []repository.SeasonScoreTotal{
+		{UserID: 1, Year: 2023, StoredScore: 20, ComputedScore: 18, Archived: true},
+		{UserID: 1, Year: 2024, StoredScore: 25, ComputedScore: 22},
+		{UserID: 2, Year: 2024, StoredScore: 0, ComputedScore: 12},
+		{UserID: 3, Year: 2024, StoredScore: 7, ComputedScore: 7},
+	}
+
+	tests := []struct {
+		name                    string
+		users                   []repository.UserScoreTotal
+		seasons                 []repository.SeasonScoreTotal
+		fix                     bool
+		wantUserDiscrepancies   int
+		wantSeasonDiscrepancies int
+		wantDrift               int
+		wantFixed               bool
+		wantFixedUsers          int
+		wantFixedSeasons        int
+	}{
+		{
+			name:    "sin diferencias",
+			users:   consistentUsers,
+			seasons: consistentSeasons,
+			fix:     true,
+		},
+		{
+			name:                    "solo informa",
+			users:                   driftedUsers,
+			seasons:                 driftedSeasons,
+			wantUserDiscrepancies:   2,
+			wantSeasonDiscrepancies: 3,
+			wantDrift:               8,
+		},
+		{
+			name:                    "corrige solo lo que difiere",
+			users:                   driftedUsers,
+			seasons:                 driftedSeasons,
+			fix:                     true,
+			wantUserDiscrepancies:   2,
+			wantSeasonDiscrepancies: 3,
+			wantDrift:               8,
+			wantFixed:               true,
+			wantFixedUsers:          2,
+			wantFixedSeasons:        3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &scoreTotalsRepository{users: tt.users, seasons: tt.seasons}
+			s := NewScoreService(repo)
+
+			report, apiErr := s.ReconcileScores(context.Background(), tt.fix)
+			if apiErr != nil {
+				t.Fatalf("ReconcileScores() error = %v", apiErr)
+			}
+			if report.UsersChecked != len(tt.users) || report.SeasonsChecked != len(tt.seasons) {
+				t.Errorf("revisados = %d usuarios y %d temporadas, want %d y %d",
+					report.UsersChecked, report.SeasonsChecked, len(tt.users), len(tt.seasons))
+			}
+			if report.UserDiscrepancies != tt.wantUserDiscrepancies || report.SeasonDiscrepancies != tt.wantSeasonDiscrepancies {
+				t.Errorf("diferencias = %d usuarios y %d temporadas, want %d y %d",
+					report.UserDiscrepancies, report.SeasonDiscrepancies, tt.wantUserDiscrepancies, tt.wantSeasonDiscrepancies)
+			}
+			if len(report.Discrepancies) != tt.wantUserDiscrepancies+tt.wantSeasonDiscrepancies {
+				t.Errorf("discrepancias = %d, want %d", len(report.Discrepancies), tt.wantUserDiscrepancies+tt.wantSeasonDiscrepancies)
+			}
+			if report.TotalDrift != tt.wantDrift {
+				t.Errorf("drift = %d, want %d", report.TotalDrift, tt.wantDrift)
+			}
+			if report.Fixed != tt.wantFixed {
+				t.Errorf("fixed = %v, want %v", report.Fixed, tt.wantFixed)
+			}
+			if !tt.wantFixed && repo.fixCalls > 0 {
+				t.Errorf("FixScores se llamó sin corregir")
+			}
+			if len(repo.fixedUsers) != tt.wantFixedUsers || len(repo.fixedSeasons) != tt.wantFixedSeasons {
+				t.Errorf("a corregir = %d usuarios y %d temporadas, want %d y %d",
+					len(repo.fixedUsers), len(repo.fixedSeasons), tt.wantFixedUsers, tt.wantFixedSeasons)
+			}
+			for _, d := range report.Discrepancies {
+				if d.Difference != d.StoredScore-d.ComputedScore {
+					t.Errorf("difference de %+v, want stored - computed", d)
+				}
+				if d.Season != nil && d.Username == "" {
+					t.Errorf("la temporada %d del usuario %d no tiene username", *d.Season, d.UserID)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	model "prediapp.local/db/model"
+	client "prediapp.local/prodes/internal/client"
+	prodes "prediapp.local/prodes/internal/dto"
+	repository "prediapp.local/prodes/internal/repository"
+	e "prediapp.local/prodes/pkg/utils"
+)
+
+// Cantidad de posiciones que se piden a results para resolver una fecha (cubre cualquier TopN válido)
+const survivorMaxTopN = 20
+
+type survivorService struct {
+	survivorRepo  repository.SurvivorRepository
+	sessionClient *client.HttpClient
+	resultsClient *client.HttpClient
+}
+
+type SurvivorServiceInterface interface {
+	CreateLeague(ctx context.Context, groupID int, request prodes.CreateSurvivorLeagueDTO) (prodes.ResponseSurvivorLeagueDTO, e.ApiError)
+	JoinLeague(ctx context.Context, leagueID int, request prodes.JoinSurvivorLeagueDTO) e.ApiError
+	MakePick(ctx context.Context, leagueID int, request prodes.CreateSurvivorPickDTO) (prodes.ResponseSurvivorPickDTO, e.ApiError)
+	ResolveSession(ctx context.Context, sessionID int) e.ApiError
+	GetStandings(ctx context.Context, groupID, year int) (prodes.SurvivorStandingsDTO, e.ApiError)
+	StartResolutionJob(ctx context.Context, interval time.Duration)
+}
+
+// NewSurvivorService crea el servicio del modo survivor
+func NewSurvivorService(survivorRepo repository.SurvivorRepository, sessionClient *client.HttpClient, resultsClient *client.HttpClient) SurvivorServiceInterface {
+	return &survivorService{
+		survivorRepo:  survivorRepo,
+		sessionClient: sessionClient,
+		resultsClient: resultsClient,
+	}
+}
+
+func (s *survivorService) CreateLeague(ctx context.Context, groupID int, request prodes.CreateSurvivorLeagueDTO) (prodes.ResponseSurvivorLeagueDTO, e.ApiError) {
+	// 1. Solo el creador del grupo puede abrir una liga survivor
+	role, apiErr := s.survivorRepo.GetUserRoleInGroup(ctx, groupID, request.UserID)
+	if apiErr != nil {
+		return prodes.ResponseSurvivorLeagueDTO{}, apiErr
+	}
+	if role != "creator" {
+		return prodes.ResponseSurvivorLeagueDTO{}, e.NewForbiddenApiError("Only the group creator can create a survivor league")
+	}
+
+	// 2. Validar el top requerido
+	if request.TopN == 0 {
+		request.TopN = 10
+	}
+	if request.TopN < 1 || request.TopN > survivorMaxTopN {
+		return prodes.ResponseSurvivorLeagueDTO{}, e.NewBadRequestApiError("top_n must be between 1 and 20")
+	}
+
+	// 3. Una sola liga por grupo y temporada
+	if _, apiErr := s.survivorRepo.GetLeagueByGroupAndYear(ctx, groupID, request.Year); apiErr == nil {
+		return prodes.ResponseSurvivorLeagueDTO{}, e.NewConflictApiError("The group already has a survivor league for that year")
+	} else if apiErr.Status() != http.StatusNotFound {
+		return prodes.ResponseSurvivorLeagueDTO{}, apiErr
+	}
+
+	league := &model.SurvivorLeague{
+		GroupID: groupID,
+		Year:    request.Year,
+		TopN:    request.TopN,
+	}
+	if apiErr := s.survivorRepo.CreateLeague(ctx, league); apiErr != nil {
+		return prodes.ResponseSurvivorLeagueDTO{}, apiErr
+	}
+
+	// 4. El creador queda inscripto automáticamente
+	if apiErr := s.survivorRepo.CreateEntry(ctx, &model.SurvivorEntry{
+		LeagueID: league.ID,
+		UserID:   request.UserID,
+		Status:   model.SurvivorStatusAlive,
+	}); apiErr != nil {
+		return prodes.ResponseSurvivorLeagueDTO{}, apiErr
+	}
+
+	return toResponseSurvivorLeagueDTO(league), nil
+}
+
+func (s *survivorService) JoinLeague(ctx context.Context, leagueID int, request prodes.JoinSurvivorLeagueDTO) e.ApiError {
+	league, apiErr := s.survivorRepo.GetLeagueByID(ctx, leagueID)
+	if apiErr != nil {
+		return apiErr
+	}
+	if league.Finished {
+		return e.NewForbiddenApiError("The survivor league has already finished")
+	}
+
+	// 1. Solo miembros del grupo pueden participar
+	role, apiErr := s.survivorRepo.GetUserRoleInGroup(ctx, league.GroupID, request.UserID)
+	if apiErr != nil {
+		return apiErr
+	}
+	if role != "creator" && role != "member" {
+		return e.NewForbiddenApiError("User is not a member of the group")
+	}
+
+	// 2. Evitar inscripciones duplicadas
+	if _, apiErr := s.survivorRepo.GetEntryByLeagueAndUser(ctx, leagueID, request.UserID); apiErr == nil {
+		return e.NewConflictApiError("User already joined the survivor league")
+	} else if apiErr.Status() != http.StatusNotFound {
+		return apiErr
+	}
+
+	return s.survivorRepo.CreateEntry(ctx, &model.SurvivorEntry{
+		LeagueID: leagueID,
+		UserID:   request.UserID,
+		Status:   model.SurvivorStatusAlive,
+	})
+}
+
+func (s *survivorService) MakePick(ctx context.Context, leagueID int, request prodes.CreateSurvivorPickDTO) (prodes.ResponseSurvivorPickDTO, e.ApiError) {
+	league, apiErr := s.survivorRepo.GetLeagueByID(ctx, leagueID)
+	if apiErr != nil {
+		return prodes.ResponseSurvivorPickDTO{}, apiErr
+	}
+	if league.Finished {
+		return prodes.ResponseSurvivorPickDTO{}, e.NewForbiddenApiError("The survivor league has already finished")
+	}
+
+	// 1. La sesión debe ser una carrera de la temporada de la liga y no haber comenzado
+	sessionDetails, err := s.sessionClient.GetSessionByID(request.SessionID)
+	if err != nil {
+		return prodes.ResponseSurvivorPickDTO{}, e.NewInternalServerApiError("Error fetching session details", err)
+	}
+	if !isRaceSession(sessionDetails.SessionName, sessionDetails.SessionType) {
+		return prodes.ResponseSurvivorPickDTO{}, e.NewBadRequestApiError("Survivor picks are only allowed for race sessions")
+	}
+	if sessionDetails.Year != 0 && sessionDetails.Year != league.Year {
+		return prodes.ResponseSurvivorPickDTO{}, e.NewBadRequestApiError("Session does not belong to the survivor league season")
+	}
+	if time.Now().After(sessionDetails.DateStart) {
+		return prodes.ResponseSurvivorPickDTO{}, e.NewForbiddenApiError("No se puede elegir piloto, la carrera ya ha comenzado")
+	}
+
+	// 2. El participante debe seguir con vida
+	entry, apiErr := s.survivorRepo.GetEntryByLeagueAndUser(ctx, leagueID, request.UserID)
+	if apiErr != nil {
+		return prodes.ResponseSurvivorPickDTO{}, apiErr
+	}
+	if entry.Status != model.SurvivorStatusAlive {
+		return prodes.ResponseSurvivorPickDTO{}, e.NewForbiddenApiError("User has been eliminated from the survivor league")
+	}
+
+	// 3. No se puede repetir piloto en la temporada; el pick de la misma carrera se puede cambiar
+	picks, apiErr := s.survivorRepo.GetPicksByEntry(ctx, entry.ID)
+	if apiErr != nil {
+		return prodes.ResponseSurvivorPickDTO{}, apiErr
+	}
+	pick := &model.SurvivorPick{
+		EntryID:   entry.ID,
+		SessionID: request.SessionID,
+		Result:    model.SurvivorPickPending,
+	}
+	for _, p := range picks {
+		if p.SessionID == request.SessionID {
+			pick = p
+			continue
+		}
+		if p.DriverID == request.DriverID {
+			return prodes.ResponseSurvivorPickDTO{}, e.NewConflictApiError("Driver already used in this survivor season")
+		}
+	}
+	pick.DriverID = request.DriverID
+
+	if apiErr := s.survivorRepo.SavePick(ctx, pick); apiErr != nil {
+		return prodes.ResponseSurvivorPickDTO{}, apiErr
+	}
+
+	return toResponseSurvivorPickDTO(pick), nil
+}
+
+// ResolveSession marca los picks de una carrera como sobrevividos o fallados y elimina a los participantes.
+// Es idempotente: solo se procesan ligas con picks pendientes para la sesión.
+func (s *survivorService) ResolveSession(ctx context.Context, sessionID int) e.ApiError {
+	// 1. Obtener la sesión y validar que sea carrera
+	sessionDetails, err := s.sessionClient.GetSessionByID(sessionID)
+	if err != nil {
+		return e.NewInternalServerApiError("Error fetching session details", err)
+	}
+	if !isRaceSession(sessionDetails.SessionName, sessionDetails.SessionType) {
+		return e.NewBadRequestApiError("Survivor can only be resolved for race sessions")
+	}
+
+	// 2. Obtener la clasificación una sola vez; cada liga usa su propio TopN
+	topDrivers, err := s.resultsClient.GetTopDriversBySession(sessionID, survivorMaxTopN)
+	if err != nil {
+		return e.NewInternalServerApiError("Error fetching top drivers for race session", err)
+	}
+	if len(topDrivers) == 0 {
+		return e.NewBadRequestApiError("The session has no results yet")
+	}
+	positions := make(map[int]int, len(topDrivers))
+	for _, d := range topDrivers {
+		positions[d.DriverID] = d.Position
+	}
+
+	year := sessionDetails.Year
+	if year == 0 {
+		year = sessionDetails.DateStart.Year()
+	}
+	leagues, apiErr := s.survivorRepo.GetActiveLeaguesByYear(ctx, year)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	// 3. Resolver cada liga de la temporada
+	for _, league := range leagues {
+		if apiErr := s.resolveLeagueSession(ctx, league, sessionID, positions); apiErr != nil {
+			return apiErr
+		}
+	}
+	return nil
+}
+
+func (s *survivorService) resolveLeagueSession(ctx context.Context, league *model.SurvivorLeague, sessionID int, positions map[int]int) e.ApiError {
+	picks, apiErr := s.survivorRepo.GetPicksByLeagueAndSession(ctx, league.ID, sessionID)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	pickByEntry := make(map[int]*model.SurvivorPick, len(picks))
+	pending := 0
+	for _, p := range picks {
+		pickByEntry[p.EntryID] = p
+		if p.Result == model.SurvivorPickPending {
+			pending++
+		}
+	}
+	// Sin picks pendientes la fecha ya fue resuelta (o nadie jugó)
+	if pending == 0 {
+		return nil
+	}
+
+	entries, apiErr := s.survivorRepo.GetEntriesByLeague(ctx, league.ID)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	// 1. Evaluar cada pick: sobrevive si el piloto terminó dentro del top de la liga
+	var resolvedPicks []*model.SurvivorPick
+	var survivors, fallen []*model.SurvivorEntry
+	for _, entry := range entries {
+		pick, ok := pickByEntry[entry.ID]
+		if entry.Status != model.SurvivorStatusAlive {
+			// El pick de alguien que ya no está en juego se anula para que no quede pendiente
+			if ok && pick.Result == model.SurvivorPickPending {
+				pick.Result = model.SurvivorPickVoid
+				resolvedPicks = append(resolvedPicks, pick)
+			}
+			continue
+		}
+		if ok && pick.Result == model.SurvivorPickPending {
+			if pos, found := positions[pick.DriverID]; found && pos >= 1 && pos <= league.TopN {
+				pick.Result = model.SurvivorPickSurvived
+			} else {
+				pick.Result = model.SurvivorPickMissed
+			}
+			resolvedPicks = append(resolvedPicks, pick)
+		}
+		// Quien no eligió piloto queda eliminado
+		if ok && pick.Result == model.SurvivorPickSurvived {
+			survivors = append(survivors, entry)
+		} else {
+			fallen = append(fallen, entry)
+		}
+	}
+
+	// 2. Si todos los que seguían vivos fallan, nadie queda eliminado en esta fecha
+	var updatedEntries []*model.SurvivorEntry
+	if len(survivors) > 0 {
+		for _, entry := range fallen {
+			entry.Status = model.SurvivorStatusEliminated
+			sid := sessionID
+			entry.EliminatedSessionID = &sid
+			updatedEntries = append(updatedEntries, entry)
+		}
+	}
+
+	// 3. El último en pie gana la liga, siempre que haya tenido con quién competir
+	if len(survivors) == 1 && len(entries) > 1 {
+		winner := survivors[0]
+		winner.Status = model.SurvivorStatusWinner
+		updatedEntries = append(updatedEntries, winner)
+		league.Finished = true
+		league.WinnerUserID = &winner.UserID
+	}
+
+	return s.survivorRepo.SaveRoundResolution(ctx, league, updatedEntries, resolvedPicks)
+}
+
+func (s *survivorService) GetStandings(ctx context.Context, groupID, year int) (prodes.SurvivorStandingsDTO, e.ApiError) {
+	if year == 0 {
+		year = time.Now().Year()
+	}
+
+	league, apiErr := s.survivorRepo.GetLeagueByGroupAndYear(ctx, groupID, year)
+	if apiErr != nil {
+		return prodes.SurvivorStandingsDTO{}, apiErr
+	}
+	entries, apiErr := s.survivorRepo.GetEntriesByLeague(ctx, league.ID)
+	if apiErr != nil {
+		return prodes.SurvivorStandingsDTO{}, apiErr
+	}
+	picks, apiErr := s.survivorRepo.GetPicksByLeague(ctx, league.ID)
+	if apiErr != nil {
+		return prodes.SurvivorStandingsDTO{}, apiErr
+	}
+
+	picksByEntry := make(map[int][]*model.SurvivorPick)
+	for _, p := range picks {
+		picksByEntry[p.EntryID] = append(picksByEntry[p.EntryID], p)
+	}
+
+	standings := make([]prodes.SurvivorStandingDTO, 0, len(entries))
+	for _, entry := range entries {
+		row := prodes.SurvivorStandingDTO{
+			UserID:              entry.UserID,
+			Status:              entry.Status,
+			UsedDrivers:         []int{},
+			EliminatedSessionID: entry.EliminatedSessionID,
+		}
+		if entry.User != nil {
+			row.Username = entry.User.Username
+		}
+		for _, p := range picksByEntry[entry.ID] {
+			row.UsedDrivers = append(row.UsedDrivers, p.DriverID)
+			if p.Result == model.SurvivorPickSurvived {
+				row.RoundsSurvived++
+			}
+		}
+		standings = append(standings, row)
+	}
+
+	// Ganador y vivos primero, luego por cantidad de fechas sobrevividas
+	sort.SliceStable(standings, func(i, j int) bool {
+		ri, rj := survivorStatusRank(standings[i].Status), survivorStatusRank(standings[j].Status)
+		if ri != rj {
+			return ri < rj
+		}
+		return standings[i].RoundsSurvived > standings[j].RoundsSurvived
+	})
+
+	return prodes.SurvivorStandingsDTO{
+		League:    toResponseSurvivorLeagueDTO(league),
+		Standings: standings,
+	}, nil
+}
+
+// StartResolutionJob resuelve periódicamente las carreras con resultados oficiales y picks pendientes
+func (s *survivorService) StartResolutionJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sessionIDs, apiErr := s.survivorRepo.GetSessionsPendingResolution(ctx)
+			if apiErr != nil {
+				log.Printf("Survivor job: error buscando sesiones pendientes: %v", apiErr)
+				continue
+			}
+			for _, sessionID := range sessionIDs {
+				if apiErr := s.ResolveSession(ctx, sessionID); apiErr != nil {
+					log.Printf("Survivor job: error resolviendo la sesión %d: %v", sessionID, apiErr)
+				}
+			}
+		}
+	}
+}
+
+func survivorStatusRank(status string) int {
+	switch status {
+	case model.SurvivorStatusWinner:
+		return 0
+	case model.SurvivorStatusAlive:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func toResponseSurvivorLeagueDTO(league *model.SurvivorLeague) prodes.ResponseSurvivorLeagueDTO {
+	return prodes.ResponseSurvivorLeagueDTO{
+		ID:           league.ID,
+		GroupID:      league.GroupID,
+		Year:         league.Year,
+		TopN:         league.TopN,
+		Finished:     league.Finished,
+		WinnerUserID: league.WinnerUserID,
+	}
+}
+
+func toResponseSurvivorPickDTO(pick *model.SurvivorPick) prodes.ResponseSurvivorPickDTO {
+	return prodes.ResponseSurvivorPickDTO{
+		ID:        pick.ID,
+		EntryID:   pick.EntryID,
+		SessionID: pick.SessionID,
+		DriverID:  pick.DriverID,
+		Result:    pick.Result,
+	}
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"prediapp.local/db/model"
+	"prediapp.local/prodes/internal/repository"
+	e "prediapp.local/prodes/pkg/utils"
+)
+
+// survivorRoundRepository es un SurvivorRepository en memoria para resolver una sola fecha de una liga
+type survivorRoundRepository struct {
+	repository.SurvivorRepository
+	entries []*model.SurvivorEntry
+	picks   []*model.SurvivorPick
+	saved   bool
+}
+
+func (r *survivorRoundRepository) GetPicksByLeagueAndSession(ctx context.Context, leagueID, sessionID int) ([]*model.SurvivorPick, e.ApiError) {
+	return r.picks, nil
+}
+
+func (r *survivorRoundRepository) GetEntriesByLeague(ctx context.Context, leagueID int) ([]*model.SurvivorEntry, e.ApiError) {
+	return r.entries, nil
+}
+
+func (r *survivorRoundRepository) SaveRoundResolution(ctx context.Context, league *model.SurvivorLeague, entries []*model.SurvivorEntry, picks []*model.SurvivorPick) e.ApiError {
+	r.saved = true
+	return nil
+}
+
+func TestResolveLeagueSession(t *testing.T) {
+	const sessionID = 7
+	// Clasificación de la carrera: driver_id -> posición
+	positions := map[int]int{10: 1, 20: 2, 30: 3, 40: 4, 50: 5}
+
+	entry := func(id int, status string) *model.SurvivorEntry {
+		return &model.SurvivorEntry{ID: id, UserID: id * 100, Status: status}
+	}
+	pick := func(entryID, driverID int, result string) *model.SurvivorPick {
+		return &model.SurvivorPick{EntryID: entryID, SessionID: sessionID, DriverID: driverID, Result: result}
+	}
+	alive, eliminated := model.SurvivorStatusAlive, model.SurvivorStatusEliminated
+	pending := model.SurvivorPickPending
+	userID := func(v int) *int { return &v }
+
+	tests := []struct {
+		name         string
+		entries      []*model.SurvivorEntry
+		picks        []*model.SurvivorPick
+		wantStatuses map[int]string // entry -> status
+		wantPicks    map[int]string // entry -> resultado del pick
+		wantWinner   *int
+		wantSaved    bool
+	}{
+		{
+			name:         "sobreviven los que terminan en el top; fallar o no elegir elimina",
+			entries:      []*model.SurvivorEntry{entry(1, alive), entry(2, alive), entry(3, alive), entry(4, alive)},
+			picks:        []*model.SurvivorPick{pick(1, 10, pending), pick(2, 50, pending), pick(4, 30, pending)},
+			wantStatuses: map[int]string{1: alive, 2: eliminated, 3: eliminated, 4: alive},
+			wantPicks:    map[int]string{1: model.SurvivorPickSurvived, 2: model.SurvivorPickMissed, 4: model.SurvivorPickSurvived},
+			wantSaved:    true,
+		},
+		{
+			name:         "si todos fallan nadie queda eliminado",
+			entries:      []*model.SurvivorEntry{entry(1, alive), entry(2, alive)},
+			picks:        []*model.SurvivorPick{pick(1, 40, pending), pick(2, 99, pending)},
+			wantStatuses: map[int]string{1: alive, 2: alive},
+			wantPicks:    map[int]string{1: model.SurvivorPickMissed, 2: model.SurvivorPickMissed},
+			wantSaved:    true,
+		},
+		{
+			name:         "el último en pie gana la liga",
+			entries:      []*model.SurvivorEntry{entry(1, alive), entry(2, alive), entry(3, eliminated)},
+			picks:        []*model.SurvivorPick{pick(1, 20, pending), pick(2, 40, pending)},
+			wantStatuses: map[int]string{1: model.SurvivorStatusWinner, 2: eliminated, 3: eliminated},
+			wantPicks:    map[int]string{1: model.SurvivorPickSurvived, 2: model.SurvivorPickMissed},
+			wantWinner:   userID(100),
+			wantSaved:    true,
+		},
+		{
+			name:         "el pick pendiente de un eliminado se anula",
+			entries:      []*model.SurvivorEntry{entry(1, eliminated), entry(2, alive), entry(3, alive)},
+			picks:        []*model.SurvivorPick{pick(1, 10, pending), pick(2, 20, pending), pick(3, 30, pending)},
+			wantStatuses: map[int]string{1: eliminated, 2: alive, 3: alive},
+			wantPicks:    map[int]string{1: model.SurvivorPickVoid, 2: model.SurvivorPickSurvived, 3: model.SurvivorPickSurvived},
+			wantSaved:    true,
+		},
+		{
+			name:         "una liga de un solo participante no se gana",
+			entries:      []*model.SurvivorEntry{entry(1, alive)},
+			picks:        []*model.SurvivorPick{pick(1, 10, pending)},
+			wantStatuses: map[int]string{1: alive},
+			wantPicks:    map[int]string{1: model.SurvivorPickSurvived},
+			wantSaved:    true,
+		},
+		{
+			name:         "sin picks pendientes la fecha ya está resuelta",
+			entries:      []*model.SurvivorEntry{entry(1, alive), entry(2, alive)},
+			picks:        []*model.SurvivorPick{pick(1, 10, model.SurvivorPickSurvived), pick(2, 50, model.SurvivorPickMissed)},
+			wantStatuses: map[int]string{1: alive, 2: alive},
+			wantPicks:    map[int]string{1: model.SurvivorPickSurvived, 2: model.SurvivorPickMissed},
+			wantSaved:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &survivorRoundRepository{entries: tt.entries, picks: tt.picks}
+			s := &survivorService{survivorRepo: repo}
+			league := &model.SurvivorLeague{ID: 1, TopN: 3}
+			wasAlive := make(map[int]bool, len(tt.entries))
+			for _, entry := range tt.entries {
+				wasAlive[entry.ID] = entry.Status == alive
+			}
+
+			if apiErr := s.resolveLeagueSession(context.Background(), league, sessionID, positions); apiErr != nil {
+				t.Fatalf("resolveLeagueSession() error = %v", apiErr)
+			}
+
+			if repo.saved != tt.wantSaved {
+				t.Errorf("saved = %v, want %v", repo.saved, tt.wantSaved)
+			}
+			for _, entry := range tt.entries {
+				if entry.Status != tt.wantStatuses[entry.ID] {
+					t.Errorf("entry %d status = %q, want %q", entry.ID, entry.Status, tt.wantStatuses[entry.ID])
+				}
+				eliminatedHere := entry.EliminatedSessionID != nil && *entry.EliminatedSessionID == sessionID
+				if eliminatedHere != (wasAlive[entry.ID] && entry.Status == eliminated) {
+					t.Errorf("entry %d eliminated_session_id = %v", entry.ID, entry.EliminatedSessionID)
+				}
+			}
+			for _, pick := range tt.picks {
+				if pick.Result != tt.wantPicks[pick.EntryID] {
+					t.Errorf("pick de la entry %d = %q, want %q", pick.EntryID, pick.Result, tt.wantPicks[pick.EntryID])
+				}
+			}
+			if (tt.wantWinner != nil) != league.Finished {
+				t.Errorf("league.Finished = %v, want %v", league.Finished, tt.wantWinner != nil)
+			}
+			if tt.wantWinner != nil && (league.WinnerUserID == nil || *league.WinnerUserID != *tt.wantWinner) {
+				t.Errorf("league.WinnerUserID = %v, want %d", league.WinnerUserID, *tt.wantWinner)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// openf1-mock sirve un directorio de respuestas grabadas de OpenF1 con la misma interfaz HTTP
+// que la API real, para correr el flujo completo de un fin de semana sin red.
+//
+// Uso:
+//
+//	go run ./cmd/openf1-mock -dir ./fixtures/2024-bahrain -addr :8090
+//
+// y en los servicios: OPEN_F1_API_URL=http://localhost:8090/v1
+//
+// El directorio tiene el mismo formato que el provider de fixtures (RACE_DATA_FIXTURES_DIR):
+// <endpoint>.json o <endpoint>/*.json con arrays de registros de OpenF1.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"prediapp.local/racedata"
+)
+
+func main() {
+	dir := flag.String("dir", os.Getenv("RACE_DATA_FIXTURES_DIR"), "directorio con los JSON grabados")
+	addr := flag.String("addr", ":8090", "dirección en la que escuchar")
+	flag.Parse()
+
+	if *dir == "" {
+		log.Fatal("falta -dir (o RACE_DATA_FIXTURES_DIR)")
+	}
+
+	store, err := racedata.NewFixtureStore(*dir)
+	if err != nil {
+		log.Fatalf("fixtures: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	for _, endpoint := range racedata.Endpoints {
+		mux.HandleFunc("/v1/"+endpoint, endpointHandler(store, endpoint))
+	}
+
+	server := &http.Server{Addr: *addr, Handler: logRequests(mux)}
+	go func() {
+		log.Printf("OpenF1 mock sirviendo %s en %s/v1", *dir, *addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Fallo al correr en %s: %v", *addr, err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+	log.Println("Deteniendo OpenF1 mock...")
+	server.Close()
+}
+
+// endpointHandler responde los registros del endpoint que cumplen los filtros de la query,
+// con los mismos operadores que la API real (=, >, <, >=, <=)
+func endpointHandler(store *racedata.FixtureStore, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"detail": "method not allowed"})
+			return
+		}
+
+		filters, err := racedata.ParseFilters(r.URL.RawQuery)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"detail": err.Error()})
+			return
+		}
+
+		records, err := store.Query(endpoint, filters)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"detail": err.Error()})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, records)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("error escribiendo respuesta: %v", err)
+	}
+}
+
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Printf("%s %s", r.Method, r.URL.RequestURI())
+		next.ServeHTTP(w, r)
+	})
+}
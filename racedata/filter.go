@@ -0,0 +1,138 @@
+package racedata
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Operadores de comparación soportados, igual que en OpenF1 (ej: ?lap_number>=10&date<2024-03-02)
+const (
+	OpEq  = "="
+	OpGt  = ">"
+	OpGte = ">="
+	OpLt  = "<"
+	OpLte = "<="
+)
+
+// Filter es una condición sobre un campo de los registros
+type Filter struct {
+	Field string
+	Op    string
+	Value string
+}
+
+// Eq crea un filtro de igualdad
+func Eq(field, value string) Filter {
+	return Filter{Field: field, Op: OpEq, Value: value}
+}
+
+// Encode devuelve el filtro en formato de query string de OpenF1
+func (f Filter) Encode() string {
+	return url.QueryEscape(f.Field) + f.Op + url.QueryEscape(f.Value)
+}
+
+// EncodeFilters arma la query string completa
+func EncodeFilters(filters []Filter) string {
+	parts := make([]string, 0, len(filters))
+	for _, f := range filters {
+		parts = append(parts, f.Encode())
+	}
+	return strings.Join(parts, "&")
+}
+
+// ParseFilters interpreta una query string cruda al estilo OpenF1. Se parsea a mano porque
+// url.ParseQuery no entiende los operadores < y > (ej: "date>2023-09-16" no tiene "=").
+func ParseFilters(rawQuery string) ([]Filter, error) {
+	var filters []Filter
+	for _, part := range strings.Split(rawQuery, "&") {
+		if part == "" {
+			continue
+		}
+		decoded, err := url.QueryUnescape(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query parameter %q: %w", part, err)
+		}
+
+		idx := strings.IndexAny(decoded, "<>=")
+		if idx <= 0 {
+			return nil, fmt.Errorf("invalid query parameter %q", decoded)
+		}
+		field, rest := decoded[:idx], decoded[idx:]
+
+		var op string
+		switch {
+		case strings.HasPrefix(rest, OpGte):
+			op = OpGte
+		case strings.HasPrefix(rest, OpLte):
+			op = OpLte
+		case strings.HasPrefix(rest, OpGt):
+			op = OpGt
+		case strings.HasPrefix(rest, OpLt):
+			op = OpLt
+		default:
+			op = OpEq
+		}
+		filters = append(filters, Filter{Field: field, Op: op, Value: strings.TrimPrefix(rest, op)})
+	}
+	return filters, nil
+}
+
+// Match indica si un registro (decodificado como mapa) cumple todos los filtros
+func Match(record map[string]interface{}, filters []Filter) bool {
+	for _, f := range filters {
+		value, ok := record[f.Field]
+		if !ok || value == nil {
+			return false
+		}
+		if !compare(formatValue(value), f.Op, f.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// compare compara numéricamente si ambos lados son números; si no, como texto
+// (las fechas ISO 8601 se ordenan bien lexicográficamente).
+func compare(actual, op, expected string) bool {
+	cmp := 0
+	a, errA := strconv.ParseFloat(actual, 64)
+	b, errB := strconv.ParseFloat(expected, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case a < b:
+			cmp = -1
+		case a > b:
+			cmp = 1
+		}
+	} else {
+		cmp = strings.Compare(actual, expected)
+	}
+
+	switch op {
+	case OpGt:
+		return cmp > 0
+	case OpGte:
+		return cmp >= 0
+	case OpLt:
+		return cmp < 0
+	case OpLte:
+		return cmp <= 0
+	default:
+		return cmp == 0
+	}
+}
@@ -0,0 +1,165 @@
+package racedata
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// FixtureStore lee registros grabados de OpenF1 desde un directorio. Por cada endpoint acepta:
+//
+//	<dir>/<endpoint>.json        un array JSON con los registros
+//	<dir>/<endpoint>/*.json      varios arrays (ej: uno por sesión) que se concatenan
+//
+// Los archivos se leen una sola vez y quedan en memoria.
+type FixtureStore struct {
+	dir     string
+	mu      sync.Mutex
+	records map[string][]map[string]interface{}
+}
+
+// NewFixtureStore crea un store sobre dir, que debe existir
+func NewFixtureStore(dir string) (*FixtureStore, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("fixtures dir %s: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("fixtures dir %s is not a directory", dir)
+	}
+	return &FixtureStore{dir: dir, records: make(map[string][]map[string]interface{})}, nil
+}
+
+// Query devuelve los registros del endpoint que cumplen todos los filtros
+func (s *FixtureStore) Query(endpoint string, filters []Filter) ([]map[string]interface{}, error) {
+	records, err := s.load(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]map[string]interface{}, 0)
+	for _, record := range records {
+		if Match(record, filters) {
+			matched = append(matched, record)
+		}
+	}
+	return matched, nil
+}
+
+func (s *FixtureStore) load(endpoint string) ([]map[string]interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if records, ok := s.records[endpoint]; ok {
+		return records, nil
+	}
+
+	var files []string
+	single := filepath.Join(s.dir, endpoint+".json")
+	if _, err := os.Stat(single); err == nil {
+		files = append(files, single)
+	}
+	many, err := filepath.Glob(filepath.Join(s.dir, endpoint, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s fixtures: %w", endpoint, err)
+	}
+	sort.Strings(many)
+	files = append(files, many...)
+
+	records := make([]map[string]interface{}, 0)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading fixture %s: %w", file, err)
+		}
+		var fileRecords []map[string]interface{}
+		if err := json.Unmarshal(data, &fileRecords); err != nil {
+			return nil, fmt.Errorf("error decoding fixture %s: %w", file, err)
+		}
+		records = append(records, fileRecords...)
+	}
+
+	s.records[endpoint] = records
+	return records, nil
+}
+
+// FixtureProvider implementa RaceDataProvider sobre un FixtureStore, para trabajar sin red
+type FixtureProvider struct {
+	store *FixtureStore
+}
+
+// NewFixtureProvider crea un provider que lee los fixtures de dir
+func NewFixtureProvider(dir string) (*FixtureProvider, error) {
+	store, err := NewFixtureStore(dir)
+	if err != nil {
+		return nil, err
+	}
+	return &FixtureProvider{store: store}, nil
+}
+
+func (p *FixtureProvider) GetSessions(filter SessionFilter) ([]Session, error) {
+	var sessions []Session
+	err := p.query(EndpointSessions, sessionFilters(filter), &sessions)
+	return sessions, err
+}
+
+func (p *FixtureProvider) GetPositions(sessionKey int) ([]Position, error) {
+	var positions []Position
+	err := p.query(EndpointPosition, sessionAndDriverFilters(sessionKey, 0), &positions)
+	return positions, err
+}
+
+func (p *FixtureProvider) GetLaps(sessionKey int, driverNumber int) ([]Lap, error) {
+	var laps []Lap
+	err := p.query(EndpointLaps, sessionAndDriverFilters(sessionKey, driverNumber), &laps)
+	return laps, err
+}
+
+func (p *FixtureProvider) GetDrivers(sessionKey int) ([]Driver, error) {
+	var drivers []Driver
+	err := p.query(EndpointDrivers, sessionAndDriverFilters(sessionKey, 0), &drivers)
+	return drivers, err
+}
+
+func (p *FixtureProvider) GetRaceControl(sessionKey int) ([]RaceControlEvent, error) {
+	var events []RaceControlEvent
+	err := p.query(EndpointRaceControl, sessionAndDriverFilters(sessionKey, 0), &events)
+	return events, err
+}
+
+func (p *FixtureProvider) GetPitStops(sessionKey int) ([]PitStop, error) {
+	var pitStops []PitStop
+	err := p.query(EndpointPit, sessionAndDriverFilters(sessionKey, 0), &pitStops)
+	return pitStops, err
+}
+
+func (p *FixtureProvider) GetIntervals(sessionKey int, driverNumber int) ([]Interval, error) {
+	var intervals []Interval
+	err := p.query(EndpointIntervals, sessionAndDriverFilters(sessionKey, driverNumber), &intervals)
+	return intervals, err
+}
+
+func (p *FixtureProvider) GetWeather(sessionKey int) ([]Weather, error) {
+	var weather []Weather
+	err := p.query(EndpointWeather, sessionAndDriverFilters(sessionKey, 0), &weather)
+	return weather, err
+}
+
+// query filtra los registros y los convierte al tipo de destino pasando por JSON
+func (p *FixtureProvider) query(endpoint string, filters []Filter, out interface{}) error {
+	records, err := p.store.Query(endpoint, filters)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		return fmt.Errorf("error encoding %s fixtures: %w", endpoint, err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("error decoding %s fixtures: %w", endpoint, err)
+	}
+	return nil
+}
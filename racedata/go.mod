@@ -0,0 +1,3 @@
+module prediapp.local/racedata
+
+go 1.23.7
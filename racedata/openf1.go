@@ -0,0 +1,101 @@
+package racedata
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// OpenF1Provider obtiene los datos de la API HTTP de OpenF1 (o de cualquier servidor compatible)
+type OpenF1Provider struct {
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewOpenF1Provider crea un provider apuntando a baseURL (ej: https://api.openf1.org/v1)
+func NewOpenF1Provider(baseURL string) *OpenF1Provider {
+	return &OpenF1Provider{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		HTTPClient: &http.Client{
+			Timeout: time.Second * 30,
+		},
+	}
+}
+
+func (p *OpenF1Provider) GetSessions(filter SessionFilter) ([]Session, error) {
+	var sessions []Session
+	err := p.fetch(EndpointSessions, sessionFilters(filter), &sessions)
+	return sessions, err
+}
+
+func (p *OpenF1Provider) GetPositions(sessionKey int) ([]Position, error) {
+	var positions []Position
+	err := p.fetch(EndpointPosition, sessionAndDriverFilters(sessionKey, 0), &positions)
+	return positions, err
+}
+
+func (p *OpenF1Provider) GetLaps(sessionKey int, driverNumber int) ([]Lap, error) {
+	var laps []Lap
+	err := p.fetch(EndpointLaps, sessionAndDriverFilters(sessionKey, driverNumber), &laps)
+	return laps, err
+}
+
+func (p *OpenF1Provider) GetDrivers(sessionKey int) ([]Driver, error) {
+	var drivers []Driver
+	err := p.fetch(EndpointDrivers, sessionAndDriverFilters(sessionKey, 0), &drivers)
+	return drivers, err
+}
+
+func (p *OpenF1Provider) GetRaceControl(sessionKey int) ([]RaceControlEvent, error) {
+	var events []RaceControlEvent
+	err := p.fetch(EndpointRaceControl, sessionAndDriverFilters(sessionKey, 0), &events)
+	return events, err
+}
+
+func (p *OpenF1Provider) GetPitStops(sessionKey int) ([]PitStop, error) {
+	var pitStops []PitStop
+	err := p.fetch(EndpointPit, sessionAndDriverFilters(sessionKey, 0), &pitStops)
+	return pitStops, err
+}
+
+func (p *OpenF1Provider) GetIntervals(sessionKey int, driverNumber int) ([]Interval, error) {
+	var intervals []Interval
+	err := p.fetch(EndpointIntervals, sessionAndDriverFilters(sessionKey, driverNumber), &intervals)
+	return intervals, err
+}
+
+func (p *OpenF1Provider) GetWeather(sessionKey int) ([]Weather, error) {
+	var weather []Weather
+	err := p.fetch(EndpointWeather, sessionAndDriverFilters(sessionKey, 0), &weather)
+	return weather, err
+}
+
+// fetch hace el GET al endpoint con los filtros y decodifica la respuesta en out
+func (p *OpenF1Provider) fetch(endpoint string, filters []Filter, out interface{}) error {
+	url := fmt.Sprintf("%s/%s", p.BaseURL, endpoint)
+	if query := EncodeFilters(filters); query != "" {
+		url += "?" + query
+	}
+
+	resp, err := p.HTTPClient.Get(url)
+	if err != nil {
+		return fmt.Errorf("error fetching %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading %s response: %w", endpoint, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("received non-200 response code from %s: %d, body: %s", endpoint, resp.StatusCode, body)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("error decoding %s response: %w", endpoint, err)
+	}
+	return nil
+}
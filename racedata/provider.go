@@ -0,0 +1,118 @@
+package racedata
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Endpoints de OpenF1 que cubre el provider
+const (
+	EndpointSessions    = "sessions"
+	EndpointPosition    = "position"
+	EndpointLaps        = "laps"
+	EndpointDrivers     = "drivers"
+	EndpointRaceControl = "race_control"
+	EndpointPit         = "pit"
+	EndpointIntervals   = "intervals"
+	EndpointWeather     = "weather"
+)
+
+// Endpoints lista todos los endpoints soportados
+var Endpoints = []string{
+	EndpointSessions,
+	EndpointPosition,
+	EndpointLaps,
+	EndpointDrivers,
+	EndpointRaceControl,
+	EndpointPit,
+	EndpointIntervals,
+	EndpointWeather,
+}
+
+// SessionFilter son los filtros aceptados para buscar sesiones. Los campos vacíos no filtran.
+type SessionFilter struct {
+	SessionKey  int
+	Location    string
+	SessionName string
+	SessionType string
+	Year        int
+}
+
+// RaceDataProvider abstrae la fuente de datos de carrera (OpenF1 o fixtures grabados en disco).
+// En todos los métodos un driverNumber o sessionKey en 0 significa "sin filtrar".
+type RaceDataProvider interface {
+	GetSessions(filter SessionFilter) ([]Session, error)
+	GetPositions(sessionKey int) ([]Position, error)
+	GetLaps(sessionKey int, driverNumber int) ([]Lap, error)
+	GetDrivers(sessionKey int) ([]Driver, error)
+	GetRaceControl(sessionKey int) ([]RaceControlEvent, error)
+	GetPitStops(sessionKey int) ([]PitStop, error)
+	GetIntervals(sessionKey int, driverNumber int) ([]Interval, error)
+	GetWeather(sessionKey int) ([]Weather, error)
+}
+
+// Valores posibles de RACE_DATA_PROVIDER
+const (
+	ProviderOpenF1   = "openf1"
+	ProviderFixtures = "fixtures"
+)
+
+// DefaultOpenF1URL es la URL pública de la API
+const DefaultOpenF1URL = "https://api.openf1.org/v1"
+
+// NewProviderFromEnv crea el provider indicado en RACE_DATA_PROVIDER (por defecto openf1).
+//   - openf1: usa OPEN_F1_API_URL o la API pública.
+//   - fixtures: lee los JSON grabados en RACE_DATA_FIXTURES_DIR.
+func NewProviderFromEnv() (RaceDataProvider, error) {
+	kind := strings.ToLower(strings.TrimSpace(os.Getenv("RACE_DATA_PROVIDER")))
+	switch kind {
+	case "", ProviderOpenF1:
+		baseURL := os.Getenv("OPEN_F1_API_URL")
+		if baseURL == "" {
+			baseURL = DefaultOpenF1URL
+		}
+		return NewOpenF1Provider(baseURL), nil
+	case ProviderFixtures:
+		dir := os.Getenv("RACE_DATA_FIXTURES_DIR")
+		if dir == "" {
+			return nil, fmt.Errorf("RACE_DATA_FIXTURES_DIR is required when RACE_DATA_PROVIDER=fixtures")
+		}
+		return NewFixtureProvider(dir)
+	default:
+		return nil, fmt.Errorf("unknown RACE_DATA_PROVIDER %q", kind)
+	}
+}
+
+// sessionFilters traduce un SessionFilter a filtros de query
+func sessionFilters(filter SessionFilter) []Filter {
+	var filters []Filter
+	if filter.SessionKey != 0 {
+		filters = append(filters, Eq("session_key", fmt.Sprint(filter.SessionKey)))
+	}
+	if filter.Location != "" {
+		filters = append(filters, Eq("location", filter.Location))
+	}
+	if filter.SessionName != "" {
+		filters = append(filters, Eq("session_name", filter.SessionName))
+	}
+	if filter.SessionType != "" {
+		filters = append(filters, Eq("session_type", filter.SessionType))
+	}
+	if filter.Year != 0 {
+		filters = append(filters, Eq("year", fmt.Sprint(filter.Year)))
+	}
+	return filters
+}
+
+// sessionAndDriverFilters arma los filtros habituales por session_key y driver_number
+func sessionAndDriverFilters(sessionKey, driverNumber int) []Filter {
+	var filters []Filter
+	if sessionKey != 0 {
+		filters = append(filters, Eq("session_key", fmt.Sprint(sessionKey)))
+	}
+	if driverNumber != 0 {
+		filters = append(filters, Eq("driver_number", fmt.Sprint(driverNumber)))
+	}
+	return filters
+}
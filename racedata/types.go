@@ -0,0 +1,117 @@
+package racedata
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Los tipos de este paquete replican los registros de la API de OpenF1 (https://openf1.org).
+// Los fixtures en disco usan exactamente el mismo formato JSON.
+
+// Session es un registro de /v1/sessions
+type Session struct {
+	SessionKey       int       `json:"session_key"`
+	MeetingKey       int       `json:"meeting_key"`
+	SessionName      string    `json:"session_name"`
+	SessionType      string    `json:"session_type"`
+	Location         string    `json:"location"`
+	CountryKey       int       `json:"country_key"`
+	CountryCode      string    `json:"country_code"`
+	CountryName      string    `json:"country_name"`
+	CircuitKey       int       `json:"circuit_key"`
+	CircuitShortName string    `json:"circuit_short_name"`
+	DateStart        time.Time `json:"date_start"`
+	DateEnd          time.Time `json:"date_end"`
+	GmtOffset        string    `json:"gmt_offset"`
+	Year             int       `json:"year"`
+}
+
+// Position es un registro de /v1/position: la posición de un piloto a partir de un instante
+type Position struct {
+	SessionKey   int    `json:"session_key"`
+	MeetingKey   int    `json:"meeting_key"`
+	DriverNumber int    `json:"driver_number"`
+	Position     *int   `json:"position"`
+	Date         string `json:"date"`
+}
+
+// Lap es un registro de /v1/laps. lap_duration viene en null en vueltas sin tiempo (queda en 0).
+type Lap struct {
+	SessionKey      int      `json:"session_key"`
+	MeetingKey      int      `json:"meeting_key"`
+	DriverNumber    int      `json:"driver_number"`
+	LapNumber       int      `json:"lap_number"`
+	LapDuration     float64  `json:"lap_duration"`
+	DurationSector1 *float64 `json:"duration_sector_1"`
+	DurationSector2 *float64 `json:"duration_sector_2"`
+	DurationSector3 *float64 `json:"duration_sector_3"`
+	IsPitOutLap     bool     `json:"is_pit_out_lap"`
+	DateStart       string   `json:"date_start"`
+}
+
+// Driver es un registro de /v1/drivers
+type Driver struct {
+	SessionKey    int    `json:"session_key"`
+	MeetingKey    int    `json:"meeting_key"`
+	BroadcastName string `json:"broadcast_name"`
+	CountryCode   string `json:"country_code"`
+	DriverNumber  int    `json:"driver_number"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	FullName      string `json:"full_name"`
+	NameAcronym   string `json:"name_acronym"`
+	HeadshotURL   string `json:"headshot_url"`
+	TeamName      string `json:"team_name"`
+	TeamColour    string `json:"team_colour"`
+}
+
+// RaceControlEvent es un mensaje de dirección de carrera de /v1/race_control
+type RaceControlEvent struct {
+	SessionKey   int    `json:"session_key"`
+	MeetingKey   int    `json:"meeting_key"`
+	Category     string `json:"category"`
+	Date         string `json:"date"`
+	DriverNumber *int   `json:"driver_number"`
+	Flag         string `json:"flag"`
+	LapNumber    *int   `json:"lap_number"`
+	Message      string `json:"message"`
+	Scope        string `json:"scope"`
+	// Tanda de la clasificación en la que se emitió el mensaje (1, 2 o 3); nil fuera de la qualy
+	QualifyingPhase *int `json:"qualifying_phase"`
+}
+
+// PitStop es una parada en boxes de /v1/pit
+type PitStop struct {
+	SessionKey   int      `json:"session_key"`
+	MeetingKey   int      `json:"meeting_key"`
+	Date         string   `json:"date"`
+	DriverNumber int      `json:"driver_number"`
+	LapNumber    int      `json:"lap_number"`
+	PitDuration  *float64 `json:"pit_duration"`
+}
+
+// Interval es la distancia de un piloto al líder en un instante (/v1/intervals).
+// gap_to_leader puede venir como número, como texto ("+1 LAP") o null.
+type Interval struct {
+	SessionKey   int             `json:"session_key"`
+	MeetingKey   int             `json:"meeting_key"`
+	Date         string          `json:"date"`
+	DriverNumber int             `json:"driver_number"`
+	GapToLeader  json.RawMessage `json:"gap_to_leader"`
+	Interval     json.RawMessage `json:"interval"`
+}
+
+// Weather es una medición del clima en el circuito de /v1/weather (una por minuto aproximadamente).
+// rainfall viene como 0/1; wind_direction en grados y wind_speed en m/s.
+type Weather struct {
+	SessionKey       int     `json:"session_key"`
+	MeetingKey       int     `json:"meeting_key"`
+	Date             string  `json:"date"`
+	AirTemperature   float64 `json:"air_temperature"`
+	TrackTemperature float64 `json:"track_temperature"`
+	Humidity         float64 `json:"humidity"`
+	Pressure         float64 `json:"pressure"`
+	Rainfall         int     `json:"rainfall"`
+	WindDirection    int     `json:"wind_direction"`
+	WindSpeed        float64 `json:"wind_speed"`
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"prediapp.local/db"
+	"prediapp.local/racedata"
+	"prediapp.local/results/internal/client"
+	"prediapp.local/results/internal/dto"
+	"prediapp.local/results/internal/repository"
+	"prediapp.local/results/internal/service"
+)
+
+// Comando para cargar una temporada pasada desde el provider: crea los fines de semana, sesiones, pilotos y
+// resultados que falten.
+// Se puede volver a correr: las sesiones que ya tienen resultados se saltean, así que retoma donde quedó.
+// -force recarga solo las sesiones con resultados provisionales; los oficiales se corrigen con una enmienda.
+// Uso: go run ./cmd/backfill -year 2023 [-force]
+func main() {
+	year := flag.Int("year", 0, "temporada a cargar")
+	force := flag.Bool("force", false, "volver a cargar los resultados provisionales de las sesiones que ya los tienen")
+	flag.Parse()
+
+	if *year == 0 {
+		log.Fatalf("Falta el parámetro -year")
+	}
+	for _, v := range []string{"JWT_SECRET", "DB_HOST", "DB_PORT", "DB_USER", "DB_PASS", "DB_NAME", "DRIVERS_SERVICE_URL", "SESSIONS_SERVICE_URL"} {
+		if os.Getenv(v) == "" {
+			log.Fatalf("%s no está definida", v)
+		}
+	}
+
+	if err := db.Init(); err != nil {
+		log.Fatalf("db.Init failed: %v", err)
+	}
+	defer db.DisconnectDB()
+
+	raceData, err := racedata.NewProviderFromEnv()
+	if err != nil {
+		log.Fatalf("race data provider: %v", err)
+	}
+
+	// Los prodes no se puntúan en el backfill: los resultados quedan provisionales
+	driversClient := client.NewHttpClient(os.Getenv("DRIVERS_SERVICE_URL"))
+	sessionsClient := client.NewHttpClient(os.Getenv("SESSIONS_SERVICE_URL"))
+	resultService := service.NewResultService(repository.NewResultRepository(db.DB), driversClient, sessionsClient, nil, nil, raceData)
+
+	// Con Ctrl+C termina la sesión en curso y muestra el reporte de lo hecho hasta ahí
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	report, apiErr := resultService.BackfillSeason(ctx, *year, *force)
+	if apiErr != nil {
+		log.Fatalf("Backfill failed: %v", apiErr)
+	}
+
+	for _, s := range report.Sessions {
+		if s.Status == dto.BackfillStatusFailed {
+			log.Printf("sesión %d (%s %s): %s", s.SessionKey, s.Location, s.SessionName, s.Error)
+		}
+	}
+	log.Printf("Temporada %d: %d sesiones en el provider, %d procesadas, %d creadas, %d pilotos creados, %d con resultados cargados, %d salteadas, %d con errores",
+		report.Year, report.SessionsFound, len(report.Sessions), report.SessionsCreated, report.DriversCreated,
+		report.SessionsIngested, report.SessionsSkipped, report.SessionsFailed)
+	if report.SessionsFailed > 0 {
+		os.Exit(1)
+	}
+}
@@ -1,13 +1,16 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"prediapp.local/db"
+	"prediapp.local/racedata"
 	"prediapp.local/results/internal/api"
 	"prediapp.local/results/internal/client"
 	"prediapp.local/results/internal/repository"
@@ -22,7 +25,7 @@ func main() {
 	required := []string{
 		"PORT", "JWT_SECRET",
 		"DB_HOST", "DB_PORT", "DB_USER", "DB_PASS", "DB_NAME",
-		"DRIVERS_SERVICE_URL",
+		"DRIVERS_SERVICE_URL", "PRODES_SERVICE_URL",
 	}
 	for _, v := range required {
 		if os.Getenv(v) == "" {
@@ -39,17 +42,35 @@ func main() {
 	usersClient := client.NewHttpClient(os.Getenv("USERS_SERVICE_URL"))
 	driversClient := client.NewHttpClient(os.Getenv("DRIVERS_SERVICE_URL"))
 	sessionsClient := client.NewHttpClient(os.Getenv("SESSIONS_SERVICE_URL"))
-	externalClient := client.NewHttpClient(os.Getenv("OPEN_F1_API_URL"))
+	prodesClient := client.NewHttpClient(os.Getenv("PRODES_SERVICE_URL"))
 	// cache := utils.NewCache(30*time.Minute, 100)
 
+	// Fuente de datos de carrera: OpenF1 o fixtures en disco según RACE_DATA_PROVIDER
+	raceData, err := racedata.NewProviderFromEnv()
+	if err != nil {
+		log.Fatalf("race data provider: %v", err)
+	}
+
 	// 4) Repositorio, servicio y controlador
 	rRepo := repository.NewResultRepository(db.DB)
-	rService := service.NewResultService(rRepo, driversClient, sessionsClient, usersClient, externalClient)
+	rService := service.NewResultService(rRepo, driversClient, sessionsClient, usersClient, prodesClient, raceData)
 	rController := api.NewResultController(rService)
 
+	// Ingesta automática de resultados al terminar cada sesión
+	iRepo := repository.NewIngestionRepository(db.DB)
+	iService := service.NewIngestionService(iRepo, rService)
+	iController := api.NewIngestionController(iService)
+
+	jobCtx, cancelJobs := context.WithCancel(context.Background())
+	defer cancelJobs()
+	go iService.StartIngestionJob(jobCtx, jobInterval("RESULTS_INGESTION_INTERVAL", 10*time.Minute))
+
+	// Posiciones provisorias de las sesiones en curso
+	go rService.StartLiveJob(jobCtx, jobInterval("RESULTS_LIVE_INTERVAL", 30*time.Second))
+
 	// 5) Router
 	r := gin.Default()
-	router.MapUrls(r, rController)
+	router.MapUrls(r, rController, iController)
 
 	// 6) Servir
 	port := os.Getenv("PORT")
@@ -72,3 +93,17 @@ func main() {
 	// 	log.Printf("Clave: %s, Expiración: %s, Valor: %+v\n", entry.Key, entry.Expiration.Format(time.RFC3339), entry.Value)
 	// }
 }
+
+// jobInterval lee el intervalo de un job desde el entorno (formato time.ParseDuration)
+func jobInterval(envVar string, def time.Duration) time.Duration {
+	v := os.Getenv(envVar)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		log.Printf("%s inválido (%s), usando %s", envVar, v, def)
+		return def
+	}
+	return d
+}
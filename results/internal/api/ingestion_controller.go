@@ -0,0 +1,67 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"prediapp.local/results/internal/service"
+	e "prediapp.local/results/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type IngestionController struct {
+	ingestionService service.IngestionServiceInterface
+}
+
+// NewIngestionController crea el controlador de la ingesta automática de resultados
+func NewIngestionController(ingestionService service.IngestionServiceInterface) *IngestionController {
+	return &IngestionController{
+		ingestionService: ingestionService,
+	}
+}
+
+// ListIngestions lista el estado de ingesta de las sesiones (?status=pending|running|succeeded|failed)
+func (ic *IngestionController) ListIngestions(c *gin.Context) {
+	ingestions, apiErr := ic.ingestionService.ListIngestions(c.Request.Context(), c.Query("status"))
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, ingestions)
+}
+
+// GetIngestionStatus devuelve el estado de ingesta de una sesión
+func (ic *IngestionController) GetIngestionStatus(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	ingestion, apiErr := ic.ingestionService.GetIngestionStatus(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, ingestion)
+}
+
+// RetryIngestion fuerza un nuevo intento de carga de resultados de una sesión
+func (ic *IngestionController) RetryIngestion(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	ingestion, apiErr := ic.ingestionService.IngestSession(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, ingestion)
+}
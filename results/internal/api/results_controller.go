@@ -2,8 +2,10 @@ package api
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"prediapp.local/results/internal/dto"
 	"prediapp.local/results/internal/service"
@@ -180,9 +182,23 @@ func (rc *ResultController) GetFastestLapInSession(c *gin.Context) {
 	c.JSON(http.StatusOK, fastestLap)
 }
 
-// GetAllResults obtiene todos los resultados
+// maxResultsPageSize acota el limit de GET /results
+const maxResultsPageSize = 200
+
+// GetAllResults obtiene una página de resultados (?offset=0&limit=50, del más reciente al más viejo)
 func (rc *ResultController) GetAllResults(c *gin.Context) {
-	results, apiErr := rc.resultService.GetAllResults(c.Request.Context())
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Offset inválido"))
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit <= 0 || limit > maxResultsPageSize {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError(fmt.Sprintf("El limit debe estar entre 1 y %d", maxResultsPageSize)))
+		return
+	}
+
+	results, apiErr := rc.resultService.GetAllResults(c.Request.Context(), offset, limit)
 	if apiErr != nil {
 		c.JSON(apiErr.Status(), apiErr)
 		return
@@ -330,6 +346,22 @@ func (rc *ResultController) CreateSessionResultsAdmin(c *gin.Context) {
 		return
 	}
 
+	// Con dry_run=true solo se devuelve lo que cambiaría, sin guardar
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("El parámetro dry_run debe ser true o false"))
+		return
+	}
+	if dryRun {
+		diff, apiErr := rc.resultService.PreviewSessionResultsAdmin(c.Request.Context(), bulkRequest)
+		if apiErr != nil {
+			c.JSON(apiErr.Status(), apiErr)
+			return
+		}
+		c.JSON(http.StatusOK, diff)
+		return
+	}
+
 	createdResults, apiErr := rc.resultService.CreateSessionResultsAdmin(c.Request.Context(), bulkRequest)
 	if apiErr != nil {
 		c.JSON(apiErr.Status(), apiErr)
@@ -339,3 +371,445 @@ func (rc *ResultController) CreateSessionResultsAdmin(c *gin.Context) {
 	// Retornamos la lista de resultados creados
 	c.JSON(http.StatusCreated, createdResults)
 }
+
+// ResolveSessionProps calcula los valores reales de los props de carrera de una sesión
+func (rc *ResultController) ResolveSessionProps(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	propResults, apiErr := rc.resultService.ResolveSessionProps(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, propResults)
+}
+
+// GetSessionPropResults obtiene los props ya resueltos de una sesión
+func (rc *ResultController) GetSessionPropResults(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	propResults, apiErr := rc.resultService.GetSessionPropResults(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, propResults)
+}
+
+// SetSessionPropAnswer carga a mano el valor real de un prop de una carrera
+func (rc *ResultController) SetSessionPropAnswer(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+	propID, err := strconv.Atoi(c.Param("propID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de prop inválido"))
+		return
+	}
+
+	var request dto.SetPropAnswerDTO
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Datos inválidos"))
+		return
+	}
+
+	propResult, apiErr := rc.resultService.SetSessionPropAnswer(c.Request.Context(), sessionID, propID, request.Answer)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, propResult)
+}
+
+// GetSessionResultStatus devuelve si los resultados de la sesión son provisionales, oficiales o enmendados
+func (rc *ResultController) GetSessionResultStatus(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	status, apiErr := rc.resultService.GetSessionResultStatus(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// MarkSessionOfficial confirma los resultados de la sesión y dispara el puntaje de los prodes
+func (rc *ResultController) MarkSessionOfficial(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	status, apiErr := rc.resultService.MarkSessionOfficial(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// AmendResult modifica un resultado con un motivo y recalcula los prodes si la sesión ya estaba puntuada
+func (rc *ResultController) AmendResult(c *gin.Context) {
+	resultID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de resultado inválido"))
+		return
+	}
+
+	var request dto.AmendResultDTO
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Datos inválidos"))
+		return
+	}
+
+	response, apiErr := rc.resultService.AmendResult(c.Request.Context(), resultID, request)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// AmendSessionResults modifica varios resultados de la sesión en una sola enmienda y valida la clasificación resultante
+func (rc *ResultController) AmendSessionResults(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	var request dto.AmendSessionResultsDTO
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Datos inválidos"))
+		return
+	}
+
+	response, apiErr := rc.resultService.AmendSessionResults(c.Request.Context(), sessionID, request)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSessionAmendments devuelve el historial de enmiendas de una sesión
+func (rc *ResultController) GetSessionAmendments(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	amendments, apiErr := rc.resultService.GetSessionAmendments(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, amendments)
+}
+
+// GetSessionLaps devuelve las vueltas de todos los pilotos de una sesión
+func (rc *ResultController) GetSessionLaps(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	laps, apiErr := rc.resultService.GetSessionLaps(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, laps)
+}
+
+// GetDriverLapChart devuelve las vueltas de un piloto en una sesión
+func (rc *ResultController) GetDriverLapChart(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+	driverID, err := strconv.Atoi(c.Param("driverID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de piloto inválido"))
+		return
+	}
+
+	chart, apiErr := rc.resultService.GetDriverLapChart(c.Request.Context(), sessionID, driverID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, chart)
+}
+
+// GetSessionPitStops devuelve las paradas en boxes de una sesión y el promedio por equipo
+func (rc *ResultController) GetSessionPitStops(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	pitStops, apiErr := rc.resultService.GetSessionPitStops(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, pitStops)
+}
+
+// GetDriverStandings devuelve el campeonato de pilotos (?year=, por defecto la temporada actual)
+func (rc *ResultController) GetDriverStandings(c *gin.Context) {
+	year, err := seasonYear(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Año inválido"))
+		return
+	}
+
+	standings, apiErr := rc.resultService.GetDriverStandings(c.Request.Context(), year)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, standings)
+}
+
+// GetConstructorStandings devuelve el campeonato de constructores (?year=, por defecto la temporada actual)
+func (rc *ResultController) GetConstructorStandings(c *gin.Context) {
+	year, err := seasonYear(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Año inválido"))
+		return
+	}
+
+	standings, apiErr := rc.resultService.GetConstructorStandings(c.Request.Context(), year)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, standings)
+}
+
+// GetPointsSystems devuelve las tablas de puntos configuradas
+func (rc *ResultController) GetPointsSystems(c *gin.Context) {
+	systems, apiErr := rc.resultService.GetPointsSystems(c.Request.Context())
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, systems)
+}
+
+// SavePointsSystem crea o reemplaza la tabla de puntos de una temporada y recalcula los puntos
+func (rc *ResultController) SavePointsSystem(c *gin.Context) {
+	var request dto.PointsSystemDTO
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Datos inválidos"))
+		return
+	}
+
+	response, apiErr := rc.resultService.SavePointsSystem(c.Request.Context(), request)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// seasonYear lee el parámetro ?year=; si no viene usa la temporada actual
+func seasonYear(c *gin.Context) (int, error) {
+	value := c.Query("year")
+	if value == "" {
+		return time.Now().Year(), nil
+	}
+	return strconv.Atoi(value)
+}
+
+// ImportSessionResults recibe un archivo de clasificación (multipart: file, session_id, commit) y devuelve el
+// reporte de validación por fila. Con commit=true guarda los resultados si no hay errores.
+func (rc *ResultController) ImportSessionResults(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.PostForm("session_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+	commit, err := strconv.ParseBool(c.DefaultPostForm("commit", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("El campo commit debe ser true o false"))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Debe adjuntar el archivo en el campo file"))
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("No se pudo abrir el archivo"))
+		return
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("No se pudo leer el archivo"))
+		return
+	}
+
+	report, apiErr := rc.resultService.ImportSessionResults(c.Request.Context(), sessionID, fileHeader.Filename, content, commit)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	// Si se pidió guardar y el archivo tiene errores, no se guardó nada
+	if commit && !report.Committed {
+		c.JSON(http.StatusUnprocessableEntity, report)
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// GetQualifyingSheet devuelve la hoja de tiempos de una clasificación (Q1, Q2, Q3 y diferencia con la pole)
+func (rc *ResultController) GetQualifyingSheet(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	sheet, apiErr := rc.resultService.GetQualifyingSheet(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, sheet)
+}
+
+// GetHeadToHead compara a un piloto con sus compañeros de equipo en una temporada (?year=, por defecto la actual)
+func (rc *ResultController) GetHeadToHead(c *gin.Context) {
+	driverID, err := strconv.Atoi(c.Param("driverID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de piloto inválido"))
+		return
+	}
+	year, err := seasonYear(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Año inválido"))
+		return
+	}
+
+	headToHead, apiErr := rc.resultService.GetHeadToHead(c.Request.Context(), driverID, year)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, headToHead)
+}
+
+// GetLiveStandings devuelve las posiciones provisorias de una sesión en curso
+func (rc *ResultController) GetLiveStandings(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de sesión inválido"))
+		return
+	}
+
+	standings, apiErr := rc.resultService.GetLiveStandings(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, standings)
+}
+
+// GetDriverResultStats devuelve las estadísticas de resultados por piloto
+// (?from=&to= en formato YYYY-MM-DD, session=race|sprint, driver_id=, team=)
+func (rc *ResultController) GetDriverResultStats(c *gin.Context) {
+	rc.getResultStats(c, dto.StatsGroupDriver)
+}
+
+// GetTeamResultStats devuelve las estadísticas de resultados por equipo (mismos parámetros que por piloto)
+func (rc *ResultController) GetTeamResultStats(c *gin.Context) {
+	rc.getResultStats(c, dto.StatsGroupTeam)
+}
+
+// getResultStats lee el rango de fechas y los filtros; por defecto toma la temporada actual completa
+func (rc *ResultController) getResultStats(c *gin.Context, groupBy string) {
+	now := time.Now().UTC()
+	query := dto.ResultStatsQueryDTO{
+		GroupBy:  groupBy,
+		From:     time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(now.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Session:  c.DefaultQuery("session", "race"),
+		TeamName: c.Query("team"),
+	}
+	if value := c.Query("from"); value != "" {
+		from, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Fecha desde inválida (YYYY-MM-DD)"))
+			return
+		}
+		query.From = from
+	}
+	if value := c.Query("to"); value != "" {
+		to, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Fecha hasta inválida (YYYY-MM-DD)"))
+			return
+		}
+		query.To = to.AddDate(0, 0, 1) // La fecha hasta se incluye completa
+	}
+	if value := c.Query("driver_id"); value != "" {
+		driverID, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID de piloto inválido"))
+			return
+		}
+		query.DriverID = driverID
+	}
+
+	stats, apiErr := rc.resultService.GetResultStats(c.Request.Context(), query)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
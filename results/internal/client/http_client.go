@@ -198,25 +198,6 @@ func (c *HttpClient) Delete(endpoint string) error {
 	return nil
 }
 
-// GetPositions obtiene las posiciones de los pilotos desde la API externa para una sesión específica
-func (c *HttpClient) GetPositions(sessionKey int) ([]dto.Position, error) {
-	// Construir la URL completa para la solicitud de posiciones
-	endpoint := fmt.Sprintf("/position?session_key=%d", sessionKey)
-	println("Endpoint: ", endpoint)
-
-	body, err := c.Get(endpoint)
-	if err != nil {
-		return nil, fmt.Errorf("error fetching positions: %w", err)
-	}
-
-	var positions []dto.Position
-	if err := json.Unmarshal(body, &positions); err != nil {
-		return nil, fmt.Errorf("error decoding positions response: %w", err)
-	}
-
-	return positions, nil
-}
-
 // func (c *HttpClient) GetDriverDetails(driverNumber int, sessionKey int) (dto.ExternalDriverDetails, error) {
 // 	// Construir la URL completa para la solicitud de detalles del piloto
 // 	endpoint := fmt.Sprintf("https://api.openf1.org/v1/drivers?driver_number=%d&session_key=%d", driverNumber, sessionKey)
@@ -235,33 +216,6 @@ func (c *HttpClient) GetPositions(sessionKey int) ([]dto.Position, error) {
 // 	return driverDetails, nil
 // }
 
-// GetLaps obtiene las vueltas rápidas de un piloto específico desde la API externa
-func (c *HttpClient) GetLaps(sessionKey int, driverNumber int) ([]dto.Lap, error) {
-	// Construir la URL completa para la solicitud de laps
-	endpoint := fmt.Sprintf("/laps?session_key=%d&driver_number=%d", sessionKey, driverNumber)
-	println("Endpoint: ", endpoint)
-
-	body, err := c.Get(endpoint)
-	if err != nil {
-		return nil, fmt.Errorf("error fetching laps: %w", err)
-	}
-
-	var laps []dto.Lap
-	if err := json.Unmarshal(body, &laps); err != nil {
-		return nil, fmt.Errorf("error decoding laps response: %w", err)
-	}
-
-	// Filtrar las vueltas con lap_duration nulo o igual a 0
-	validLaps := make([]dto.Lap, 0)
-	for _, lap := range laps {
-		if lap.LapDuration > 0 {
-			validLaps = append(validLaps, lap)
-		}
-	}
-
-	return validLaps, nil
-}
-
 // Función para obtener sessionKey utilizando sessionId
 func (c *HttpClient) GetSessionKeyBySessionID(sessionID int) (int, error) {
 	endpoint := c.buildURL(fmt.Sprintf("/sessions/%d/get-session-key", sessionID))
@@ -335,6 +289,30 @@ func (c *HttpClient) GetDriverByName(firstName, lastName string) (dto.ResponseDr
 	return driver, nil
 }
 
+// GetDriversByAcronym obtiene los pilotos cuyo name_acronym coincide exactamente (sin distinguir mayúsculas)
+func (c *HttpClient) GetDriversByAcronym(acronym string) ([]dto.ResponseDriverDTO, error) {
+	endpoint := c.buildURL(fmt.Sprintf("/drivers/acronym/%s", url.PathEscape(acronym)))
+
+	body, err := c.GetWithAuth(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching drivers by acronym: %w", err)
+	}
+
+	var drivers []dto.ResponseDriverDTO
+	if err := json.Unmarshal(body, &drivers); err != nil {
+		return nil, fmt.Errorf("error decoding drivers by acronym response: %w", err)
+	}
+
+	// El microservicio de drivers busca por coincidencia parcial, nos quedamos con las exactas
+	var matches []dto.ResponseDriverDTO
+	for _, driver := range drivers {
+		if strings.EqualFold(driver.NameAcronym, acronym) {
+			matches = append(matches, driver)
+		}
+	}
+	return matches, nil
+}
+
 // Función para obtener la información de una sesión completa utilizando sessionId
 func (c *HttpClient) GetSessionByID(sessionID int) (dto.ResponseSessionDTO, error) {
 	// Usar la URL correcta del microservicio de sessions
@@ -408,3 +386,114 @@ func (c *HttpClient) buildURL(endpoint string) string {
 	// Si es relativo, combinarlo con BaseURL
 	return fmt.Sprintf("%s%s", strings.TrimRight(c.BaseURL, "/"), endpoint)
 }
+
+// RescoreSession pide al microservicio de prodes que recalcule los puntajes de una sesión.
+// Prodes aplica solo la diferencia con el puntaje anterior, así que puede repetirse sin duplicar puntos.
+func (c *HttpClient) RescoreSession(sessionID int, isRace bool) error {
+	endpoint := fmt.Sprintf("/prodes/session/%d/score", sessionID)
+	if isRace {
+		endpoint = fmt.Sprintf("/prodes/carrera/%d/score", sessionID)
+	}
+
+	if _, err := c.Post(endpoint, nil); err != nil {
+		return fmt.Errorf("error rescoring session %d: %w", sessionID, err)
+	}
+	return nil
+}
+
+// PreviewScores pide a prodes que simule los puntajes de una sesión con el top recibido, sin guardarlos
+func (c *HttpClient) PreviewScores(sessionID int, isRace bool, topDrivers []dto.TopDriverDTO) (dto.ProdesImpactDTO, error) {
+	endpoint := fmt.Sprintf("/prodes/session/%d/score/preview", sessionID)
+	if isRace {
+		endpoint = fmt.Sprintf("/prodes/carrera/%d/score/preview", sessionID)
+	}
+
+	body, err := c.Post(endpoint, map[string]interface{}{"top_drivers": topDrivers})
+	if err != nil {
+		return dto.ProdesImpactDTO{}, fmt.Errorf("error previewing scores for session %d: %w", sessionID, err)
+	}
+
+	var impact dto.ProdesImpactDTO
+	if err := json.Unmarshal(body, &impact); err != nil {
+		return dto.ProdesImpactDTO{}, fmt.Errorf("error decoding score preview: %w", err)
+	}
+	return impact, nil
+}
+
+// GetSessionsByYear lista las sesiones de una temporada cargadas en el microservicio de sessions
+func (c *HttpClient) GetSessionsByYear(year int) ([]dto.SessionSummaryDTO, error) {
+	body, err := c.GetWithAuth(c.buildURL(fmt.Sprintf("/sessions/year/%d", year)))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions for year %d: %w", year, err)
+	}
+
+	var sessions []dto.SessionSummaryDTO
+	if err := json.Unmarshal(body, &sessions); err != nil {
+		return nil, fmt.Errorf("error decoding sessions response: %w", err)
+	}
+	return sessions, nil
+}
+
+// CreateSession crea una sesión en el microservicio de sessions
+func (c *HttpClient) CreateSession(request dto.CreateSessionRequestDTO) (dto.SessionSummaryDTO, error) {
+	body, err := c.Post("/sessions", request)
+	if err != nil {
+		return dto.SessionSummaryDTO{}, fmt.Errorf("error creating session %s %s: %w", request.Location, request.SessionName, err)
+	}
+
+	var session dto.SessionSummaryDTO
+	if err := json.Unmarshal(body, &session); err != nil {
+		return dto.SessionSummaryDTO{}, fmt.Errorf("error decoding created session: %w", err)
+	}
+	return session, nil
+}
+
+// GetMeetingsByYear lista los fines de semana de una temporada cargados en el microservicio de sessions
+func (c *HttpClient) GetMeetingsByYear(year int) ([]dto.MeetingSummaryDTO, error) {
+	body, err := c.GetWithAuth(c.buildURL(fmt.Sprintf("/meetings?year=%d", year)))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching meetings for year %d: %w", year, err)
+	}
+
+	var meetings []dto.MeetingSummaryDTO
+	if err := json.Unmarshal(body, &meetings); err != nil {
+		return nil, fmt.Errorf("error decoding meetings response: %w", err)
+	}
+	return meetings, nil
+}
+
+// CreateMeeting crea un fin de semana en el microservicio de sessions
+func (c *HttpClient) CreateMeeting(request dto.CreateMeetingRequestDTO) (dto.MeetingSummaryDTO, error) {
+	body, err := c.Post("/meetings", request)
+	if err != nil {
+		return dto.MeetingSummaryDTO{}, fmt.Errorf("error creating meeting %s: %w", request.Name, err)
+	}
+
+	var meeting dto.MeetingSummaryDTO
+	if err := json.Unmarshal(body, &meeting); err != nil {
+		return dto.MeetingSummaryDTO{}, fmt.Errorf("error decoding created meeting: %w", err)
+	}
+	return meeting, nil
+}
+
+// UpdateSessionKey asigna el session_key de OpenF1 a una sesión existente
+func (c *HttpClient) UpdateSessionKey(sessionID, sessionKey int) error {
+	if _, err := c.Put(fmt.Sprintf("/sessions/%d/admin-session-key", sessionID), map[string]int{"session_key": sessionKey}); err != nil {
+		return fmt.Errorf("error updating session key of session %d: %w", sessionID, err)
+	}
+	return nil
+}
+
+// CreateDriver crea un piloto en el microservicio de drivers
+func (c *HttpClient) CreateDriver(request dto.CreateDriverRequestDTO) (dto.ResponseDriverDTO, error) {
+	body, err := c.Post("/drivers", request)
+	if err != nil {
+		return dto.ResponseDriverDTO{}, fmt.Errorf("error creating driver %d: %w", request.DriverNumber, err)
+	}
+
+	var driver dto.ResponseDriverDTO
+	if err := json.Unmarshal(body, &driver); err != nil {
+		return dto.ResponseDriverDTO{}, fmt.Errorf("error decoding created driver: %w", err)
+	}
+	return driver, nil
+}
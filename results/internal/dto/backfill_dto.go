@@ -0,0 +1,97 @@
+package dto
+
+import "time"
+
+// SessionSummaryDTO es una sesión tal como la lista el microservicio de sessions, con su session_key
+type SessionSummaryDTO struct {
+	ID          int    `json:"id"`
+	SessionKey  *int   `json:"session_key"`
+	Location    string `json:"location"`
+	SessionName string `json:"session_name"`
+	SessionType string `json:"session_type"`
+	Year        int    `json:"year"`
+}
+
+// CreateSessionRequestDTO es el cuerpo de POST /sessions del microservicio de sessions
+type CreateSessionRequestDTO struct {
+	WeekendID        int       `json:"weekend_id"`
+	CircuitKey       int       `json:"circuit_key"`
+	CircuitShortName string    `json:"circuit_short_name"`
+	CountryCode      string    `json:"country_code"`
+	CountryName      string    `json:"country_name"`
+	DateStart        time.Time `json:"date_start"`
+	DateEnd          time.Time `json:"date_end"`
+	Location         string    `json:"location"`
+	SessionKey       *int      `json:"session_key"`
+	SessionName      string    `json:"session_name"`
+	SessionType      string    `json:"session_type"`
+	Year             int       `json:"year"`
+}
+
+// MeetingSummaryDTO es un fin de semana tal como lo lista el microservicio de sessions, con su meeting_key
+type MeetingSummaryDTO struct {
+	ID         int  `json:"id"`
+	MeetingKey *int `json:"meeting_key"`
+}
+
+// CreateMeetingRequestDTO es el cuerpo de POST /meetings del microservicio de sessions
+type CreateMeetingRequestDTO struct {
+	MeetingKey       *int      `json:"meeting_key"`
+	Name             string    `json:"name"`
+	CircuitKey       int       `json:"circuit_key"`
+	CircuitShortName string    `json:"circuit_short_name"`
+	CountryCode      string    `json:"country_code"`
+	CountryKey       int       `json:"country_key"`
+	CountryName      string    `json:"country_name"`
+	Location         string    `json:"location"`
+	DateStart        time.Time `json:"date_start"`
+	DateEnd          time.Time `json:"date_end"`
+	Year             int       `json:"year"`
+}
+
+// CreateDriverRequestDTO es el cuerpo de POST /drivers del microservicio de drivers
+type CreateDriverRequestDTO struct {
+	BroadcastName string `json:"broadcast_name"`
+	CountryCode   string `json:"country_code"`
+	DriverNumber  int    `json:"driver_number"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	FullName      string `json:"full_name"`
+	NameAcronym   string `json:"name_acronym"`
+	HeadshotURL   string `json:"headshot_url,omitempty"`
+	TeamName      string `json:"team_name"`
+	Activo        bool   `json:"activo"`
+}
+
+// Estados de una sesión en el reporte del backfill
+const (
+	BackfillStatusIngested    = "ingested"     // Se cargaron los resultados
+	BackfillStatusSkipped     = "skipped"      // Ya tenía resultados (corrida anterior o carga manual), o son oficiales con -force
+	BackfillStatusNotFinished = "not_finished" // Todavía no terminó
+	BackfillStatusFailed      = "failed"
+)
+
+// BackfillSessionReportDTO es lo que hizo el backfill con una sesión del provider
+type BackfillSessionReportDTO struct {
+	SessionKey     int    `json:"session_key"`
+	SessionID      int    `json:"session_id,omitempty"`
+	Location       string `json:"location"`
+	SessionName    string `json:"session_name"`
+	SessionCreated bool   `json:"session_created"`
+	DriversCreated int    `json:"drivers_created"`
+	Results        int    `json:"results"`
+	Status         string `json:"status"`
+	Error          string `json:"error,omitempty"`
+}
+
+// BackfillReportDTO es el reporte final del backfill de una temporada
+type BackfillReportDTO struct {
+	Year             int                        `json:"year"`
+	SessionsFound    int                        `json:"sessions_found"`
+	SessionsCreated  int                        `json:"sessions_created"`
+	DriversCreated   int                        `json:"drivers_created"`
+	SessionsIngested int                        `json:"sessions_ingested"`
+	SessionsSkipped  int                        `json:"sessions_skipped"`
+	SessionsFailed   int                        `json:"sessions_failed"`
+	Sessions         []BackfillSessionReportDTO `json:"sessions"`
+}
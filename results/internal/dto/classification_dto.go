@@ -0,0 +1,37 @@
+package dto
+
+// DTO con la clasificación detallada de un resultado (grilla, vueltas, tiempos y diferencias)
+type ClassificationDTO struct {
+	GridPosition    *int     `json:"grid_position"`
+	PositionsGained *int     `json:"positions_gained"` // Positivo si ganó posiciones respecto de la grilla
+	LapsCompleted   int      `json:"laps_completed"`
+	TotalTime       *float64 `json:"total_time"` // Segundos
+	GapToLeader     *float64 `json:"gap_to_leader"`
+	IntervalToAhead *float64 `json:"interval_to_ahead"`
+	LapsBehind      int      `json:"laps_behind"`
+	Gap             string   `json:"gap,omitempty"` // Texto para mostrar: "+12.345s", "+1 Lap", "+2 Laps"
+	Points          float64  `json:"points"`        // Puntos del campeonato
+
+	// Tandas de la clasificación (solo en qualy)
+	Q1Time          *float64 `json:"q1_time,omitempty"`
+	Q2Time          *float64 `json:"q2_time,omitempty"`
+	Q3Time          *float64 `json:"q3_time,omitempty"`
+	KnockoutSegment string   `json:"knockout_segment,omitempty"`
+}
+
+// Códigos estables de las violaciones de consistencia de una clasificación completa
+const (
+	ViolationDuplicateDriver   = "duplicate_driver"
+	ViolationDuplicatePosition = "duplicate_position"
+	ViolationPositionGap       = "position_gap"
+	ViolationTooManyFinishers  = "too_many_finishers"
+	ViolationStatusPosition    = "status_position_mismatch"
+)
+
+// ClassificationViolationDTO es un problema de consistencia de la clasificación de una sesión
+type ClassificationViolationDTO struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	DriverIDs []int  `json:"driver_ids,omitempty"`
+	Position  *int   `json:"position,omitempty"`
+}
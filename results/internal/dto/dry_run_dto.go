@@ -0,0 +1,44 @@
+package dto
+
+// Acciones posibles sobre el resultado de un piloto en una carga masiva
+const (
+	ResultActionCreate    = "create"
+	ResultActionUpdate    = "update"
+	ResultActionUnchanged = "unchanged"
+)
+
+// ResultSnapshotDTO son los campos editables de un resultado, antes o después de la carga
+type ResultSnapshotDTO struct {
+	Position       *int    `json:"position"`
+	Status         string  `json:"status"`
+	FastestLapTime float64 `json:"fastest_lap_time"`
+}
+
+// ResultChangeDTO describe qué pasaría con el resultado de un piloto si se confirma la carga
+type ResultChangeDTO struct {
+	DriverID int                `json:"driver_id"`
+	Action   string             `json:"action"`           // create, update o unchanged
+	Before   *ResultSnapshotDTO `json:"before,omitempty"` // nil si el resultado no existe
+	After    ResultSnapshotDTO  `json:"after"`
+	Fields   []string           `json:"fields,omitempty"` // Campos que cambian: position, status, fastest_lap_time
+}
+
+// ProdesImpactDTO resume cuántos prodes cambiarían de puntaje con la clasificación propuesta
+type ProdesImpactDTO struct {
+	ProdesEvaluated int    `json:"prodes_evaluated"`
+	ProdesChanged   int    `json:"prodes_changed"`
+	UsersAffected   int    `json:"users_affected"`
+	TotalDelta      int    `json:"total_delta"`
+	Error           string `json:"error,omitempty"` // Si prodes no pudo simular, el diff de resultados igual es válido
+}
+
+// BulkResultsDiffDTO es la respuesta de la carga masiva con dry_run=true: lo que cambiaría, sin guardar nada
+type BulkResultsDiffDTO struct {
+	SessionID int               `json:"session_id"`
+	DryRun    bool              `json:"dry_run"`
+	ToCreate  int               `json:"to_create"`
+	ToUpdate  int               `json:"to_update"`
+	Unchanged int               `json:"unchanged"`
+	Changes   []ResultChangeDTO `json:"changes"`
+	Prodes    ProdesImpactDTO   `json:"prodes"`
+}
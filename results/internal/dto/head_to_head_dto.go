@@ -0,0 +1,33 @@
+package dto
+
+// HeadToHeadCountDTO es un conteo del piloto contra su compañero (victorias en el mano a mano, abandonos)
+type HeadToHeadCountDTO struct {
+	Driver   int `json:"driver"`
+	Teammate int `json:"teammate"`
+}
+
+// HeadToHeadPointsDTO son los puntos del campeonato de cada uno en las sesiones que compartieron
+type HeadToHeadPointsDTO struct {
+	Driver   float64 `json:"driver"`
+	Teammate float64 `json:"teammate"`
+}
+
+// TeammateHeadToHeadDTO compara al piloto con un compañero de equipo en las sesiones que corrieron los dos
+type TeammateHeadToHeadDTO struct {
+	Teammate             ResponseDriverDTO   `json:"teammate"`
+	Qualifying           HeadToHeadCountDTO  `json:"qualifying"` // Quién clasificó adelante
+	Race                 HeadToHeadCountDTO  `json:"race"`       // Quién terminó adelante (carreras y sprints)
+	Points               HeadToHeadPointsDTO `json:"points"`
+	DNFs                 HeadToHeadCountDTO  `json:"dnfs"`
+	QualifyingSessions   int                 `json:"qualifying_sessions"`
+	RaceSessions         int                 `json:"race_sessions"`
+	AverageQualifyingGap *float64            `json:"average_qualifying_gap"` // Segundos; negativo si el piloto fue más rápido
+	AverageFinishGap     *float64            `json:"average_finish_gap"`     // Posiciones; negativo si el piloto terminó adelante
+}
+
+// HeadToHeadDTO es la comparación de un piloto con sus compañeros de equipo en una temporada
+type HeadToHeadDTO struct {
+	Year      int                     `json:"year"`
+	Driver    ResponseDriverDTO       `json:"driver"`
+	Teammates []TeammateHeadToHeadDTO `json:"teammates"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+// ImportRowReportDTO es el resultado de validar una fila del archivo importado
+type ImportRowReportDTO struct {
+	Row            int      `json:"row"`        // Número de línea en el archivo (o índice + 1 en JSON)
+	DriverRef      string   `json:"driver_ref"` // Número, acrónimo o driver_id tal como vino en el archivo
+	DriverID       int      `json:"driver_id,omitempty"`
+	DriverName     string   `json:"driver_name,omitempty"`
+	Position       *int     `json:"position"`
+	Status         string   `json:"status"`
+	FastestLapTime float64  `json:"fastest_lap_time"`
+	Valid          bool     `json:"valid"`
+	Errors         []string `json:"errors,omitempty"`
+}
+
+// ImportReportDTO es el reporte de validación de una importación de resultados.
+// Si Committed es false no se guardó nada.
+type ImportReportDTO struct {
+	SessionID  int                          `json:"session_id"`
+	Format     string                       `json:"format"` // csv o json
+	TotalRows  int                          `json:"total_rows"`
+	ValidRows  int                          `json:"valid_rows"`
+	Valid      bool                         `json:"valid"`
+	Committed  bool                         `json:"committed"`
+	FileErrors []string                     `json:"file_errors,omitempty"` // Problemas que no son de una fila puntual
+	Rows       []ImportRowReportDTO         `json:"rows"`
+	Violations []ClassificationViolationDTO `json:"violations,omitempty"` // Problemas de consistencia de la clasificación completa
+	Results    []ResponseResultDTO          `json:"results,omitempty"`    // Resultados guardados, solo si Committed
+}
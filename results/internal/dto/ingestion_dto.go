@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+// SessionIngestionDTO es el estado de la carga automática de resultados de una sesión
+type SessionIngestionDTO struct {
+	SessionID     int        `json:"session_id"`
+	SessionName   string     `json:"session_name"`
+	SessionType   string     `json:"session_type"`
+	Location      string     `json:"location"`
+	DateEnd       time.Time  `json:"date_end"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error,omitempty"`
+	ResultsCount  int        `json:"results_count"`
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+// LapDTO es una vuelta de un piloto
+type LapDTO struct {
+	LapNumber   int        `json:"lap_number"`
+	LapDuration *float64   `json:"lap_duration"`
+	Sector1     *float64   `json:"sector_1"`
+	Sector2     *float64   `json:"sector_2"`
+	Sector3     *float64   `json:"sector_3"`
+	IsPitInLap  bool       `json:"is_pit_in_lap"`
+	IsPitOutLap bool       `json:"is_pit_out_lap"`
+	DateStart   *time.Time `json:"date_start,omitempty"`
+}
+
+// DriverLapChartDTO son las vueltas de un piloto en una sesión con un resumen de ritmo
+type DriverLapChartDTO struct {
+	SessionID        int               `json:"session_id"`
+	Driver           ResponseDriverDTO `json:"driver"`
+	TotalLaps        int               `json:"total_laps"`
+	FastestLap       *float64          `json:"fastest_lap"`
+	FastestLapNumber int               `json:"fastest_lap_number"`
+	AverageLap       *float64          `json:"average_lap"` // Promedio sin vueltas de entrada/salida de boxes
+	Laps             []LapDTO          `json:"laps"`
+}
+
+// SessionLapsDTO son las vueltas de todos los pilotos de una sesión
+type SessionLapsDTO struct {
+	SessionID int                 `json:"session_id"`
+	Drivers   []DriverLapChartDTO `json:"drivers"`
+}
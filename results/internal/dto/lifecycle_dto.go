@@ -0,0 +1,67 @@
+package dto
+
+import "time"
+
+// SessionResultStatusDTO es el estado de los resultados de una sesión (provisional, official o amended)
+type SessionResultStatusDTO struct {
+	SessionID    int        `json:"session_id"`
+	Status       string     `json:"status"`
+	OfficialAt   *time.Time `json:"official_at,omitempty"`
+	AmendedAt    *time.Time `json:"amended_at,omitempty"`
+	Amendments   int        `json:"amendments"`
+	Rescored     bool       `json:"rescored"`
+	RescoreError string     `json:"rescore_error,omitempty"`
+}
+
+// AmendResultDTO es el pedido de enmienda de un resultado. Los campos vacíos no se modifican.
+type AmendResultDTO struct {
+	Position       *int    `json:"position,omitempty"`
+	Status         string  `json:"status,omitempty"`
+	FastestLapTime float64 `json:"fastest_lap_time,omitempty"`
+	Reason         string  `json:"reason" binding:"required"`
+}
+
+// AmendSessionResultsDTO es una enmienda de varios resultados de la sesión a la vez, por ejemplo para
+// reclasificar la carrera tras una descalificación. Todos los cambios comparten el motivo.
+type AmendSessionResultsDTO struct {
+	Changes []AmendResultChangeDTO `json:"changes" binding:"required,min=1,dive"`
+	Reason  string                 `json:"reason" binding:"required"`
+}
+
+// AmendResultChangeDTO es el cambio de un resultado dentro de una enmienda de la sesión
+type AmendResultChangeDTO struct {
+	ResultID       int     `json:"result_id" binding:"required"`
+	Position       *int    `json:"position,omitempty"`
+	Status         string  `json:"status,omitempty"`
+	FastestLapTime float64 `json:"fastest_lap_time,omitempty"`
+}
+
+// ResultAmendmentDTO es una entrada del historial de enmiendas
+type ResultAmendmentDTO struct {
+	ID                 int       `json:"id"`
+	SessionID          int       `json:"session_id"`
+	ResultID           int       `json:"result_id"`
+	DriverID           int       `json:"driver_id"`
+	PreviousPosition   *int      `json:"previous_position"`
+	NewPosition        *int      `json:"new_position"`
+	PreviousStatus     string    `json:"previous_status"`
+	NewStatus          string    `json:"new_status"`
+	PreviousFastestLap float64   `json:"previous_fastest_lap"`
+	NewFastestLap      float64   `json:"new_fastest_lap"`
+	Reason             string    `json:"reason"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// ResultAmendmentResponseDTO es la respuesta a una enmienda: el resultado actualizado y el estado de la sesión
+type ResultAmendmentResponseDTO struct {
+	Amendment     ResultAmendmentDTO     `json:"amendment"`
+	Result        ResponseResultDTO      `json:"result"`
+	SessionStatus SessionResultStatusDTO `json:"session_status"`
+}
+
+// SessionAmendmentResponseDTO es la respuesta a una enmienda de la sesión: los resultados modificados y el estado
+type SessionAmendmentResponseDTO struct {
+	Amendments    []ResultAmendmentDTO   `json:"amendments"`
+	Results       []ResponseResultDTO    `json:"results"`
+	SessionStatus SessionResultStatusDTO `json:"session_status"`
+}
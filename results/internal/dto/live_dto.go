@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// LivePositionDTO es la posición de un piloto en una sesión en curso
+type LivePositionDTO struct {
+	Position int               `json:"position"`
+	Driver   ResponseDriverDTO `json:"driver"` // Solo el driver_number si el piloto no está cargado
+}
+
+// LiveStandingsDTO son las posiciones provisorias de una sesión en curso, según el último sondeo al provider.
+// No son resultados: no se guardan y pueden cambiar hasta que se carguen los oficiales.
+type LiveStandingsDTO struct {
+	SessionID   int               `json:"session_id"`
+	SessionName string            `json:"session_name"`
+	SessionType string            `json:"session_type"`
+	Provisional bool              `json:"provisional"` // Siempre true
+	Finished    bool              `json:"finished"`    // La sesión terminó y las posiciones son las últimas informadas
+	UpdatedAt   time.Time         `json:"updated_at"`
+	Positions   []LivePositionDTO `json:"positions"`
+}
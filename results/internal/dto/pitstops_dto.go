@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// PitStopDTO es una parada en boxes de un piloto
+type PitStopDTO struct {
+	Driver      ResponseDriverDTO `json:"driver"`
+	StopNumber  int               `json:"stop_number"` // Número de parada del piloto en la sesión (1, 2, ...)
+	LapNumber   int               `json:"lap_number"`
+	PitDuration *float64          `json:"pit_duration"`
+	Date        *time.Time        `json:"date,omitempty"`
+}
+
+// TeamPitStopsDTO resume las paradas de un equipo
+type TeamPitStopsDTO struct {
+	TeamName        string   `json:"team_name"`
+	Stops           int      `json:"stops"`
+	AverageDuration *float64 `json:"average_duration"` // Solo paradas con duración informada
+	FastestDuration *float64 `json:"fastest_duration"`
+}
+
+// SessionPitStopsDTO son las paradas en boxes de una sesión con el promedio por equipo
+type SessionPitStopsDTO struct {
+	SessionID  int               `json:"session_id"`
+	TotalStops int               `json:"total_stops"`
+	PitStops   []PitStopDTO      `json:"pit_stops"`
+	Teams      []TeamPitStopsDTO `json:"teams"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+// PropResultDTO es el valor real resuelto de un prop para una sesión
+type PropResultDTO struct {
+	PropID int    `json:"prop_id"`
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Answer string `json:"answer"`
+	Manual bool   `json:"manual"` // Cargado a mano por un admin
+}
+
+// SetPropAnswerDTO es el valor real de un prop cargado a mano por un admin
+type SetPropAnswerDTO struct {
+	Answer string `json:"answer" binding:"required"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+// QualifyingEntryDTO es una fila de la hoja de clasificación: tiempos de cada tanda y diferencia con la pole
+type QualifyingEntryDTO struct {
+	Position        *int              `json:"position"`
+	Driver          ResponseDriverDTO `json:"driver"`
+	Q1Time          *float64          `json:"q1_time"`
+	Q2Time          *float64          `json:"q2_time"`
+	Q3Time          *float64          `json:"q3_time"`
+	BestLap         float64           `json:"best_lap"`
+	KnockoutSegment string            `json:"knockout_segment"` // Q1 o Q2 si quedó eliminado ahí, Q3 si la disputó
+	GapToPole       *float64          `json:"gap_to_pole"`      // Segundos, en la última tanda que disputó
+	Gap             string            `json:"gap,omitempty"`    // Texto para mostrar: "+0.123s"
+}
+
+// QualifyingSheetDTO es la hoja de tiempos completa de una clasificación, ordenada por posición
+type QualifyingSheetDTO struct {
+	SessionID   int                  `json:"session_id"`
+	SessionName string               `json:"session_name"`
+	PoleTime    *float64             `json:"pole_time"`
+	Entries     []QualifyingEntryDTO `json:"entries"`
+}
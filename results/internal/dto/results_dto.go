@@ -28,6 +28,7 @@ type ResponseResultDTO struct {
 	Driver         ResponseDriverDTO  `json:"driver"`
 	CreatedAt      time.Time          `json:"created_at"`
 	UpdatedAt      time.Time          `json:"updated_at"`
+	ClassificationDTO
 }
 
 // DTO para los detalles de la sesión asociados al resultado
@@ -54,17 +55,6 @@ type ResponseDriverDTO struct {
     TeamName       string `json:"team_name"`
 }
 
-type Position struct {
-	DriverNumber int       `json:"driver_number"`
-	Position     *int      `json:"position"`
-	Date         string    `json:"date"`
-}
-
-type Lap struct {
-	LapNumber   int     `json:"lap_number"`
-	LapDuration float64 `json:"lap_duration"`
-}
-
 // DTO para devolver solo la posición y el ID del piloto
 type TopDriverDTO struct {
     Position int `json:"position"`
@@ -0,0 +1,50 @@
+package dto
+
+// DriverStandingDTO es la posición de un piloto en el campeonato
+type DriverStandingDTO struct {
+	Position    int     `json:"position"`
+	DriverID    int     `json:"driver_id"`
+	FullName    string  `json:"full_name"`
+	NameAcronym string  `json:"name_acronym"`
+	TeamName    string  `json:"team_name"`
+	Points      float64 `json:"points"`
+	Wins        int     `json:"wins"`
+	Podiums     int     `json:"podiums"`
+	Races       int     `json:"races"`
+}
+
+// DriverStandingsDTO es el campeonato de pilotos de una temporada
+type DriverStandingsDTO struct {
+	Year      int                 `json:"year"`
+	Standings []DriverStandingDTO `json:"standings"`
+}
+
+// ConstructorStandingDTO es la posición de un equipo en el campeonato
+type ConstructorStandingDTO struct {
+	Position int     `json:"position"`
+	TeamName string  `json:"team_name"`
+	Points   float64 `json:"points"`
+	Wins     int     `json:"wins"`
+	Podiums  int     `json:"podiums"`
+}
+
+// ConstructorStandingsDTO es el campeonato de constructores de una temporada
+type ConstructorStandingsDTO struct {
+	Year      int                      `json:"year"`
+	Standings []ConstructorStandingDTO `json:"standings"`
+}
+
+// PointsSystemDTO es una tabla de puntos; rige desde Year hasta la próxima tabla del mismo tipo
+type PointsSystemDTO struct {
+	Year                  int       `json:"year" binding:"required"`
+	SessionKind           string    `json:"session_kind" binding:"required,oneof=race sprint"`
+	Points                []float64 `json:"points" binding:"required,min=1"` // Puntos de P1, P2, ...
+	FastestLapPoints      int       `json:"fastest_lap_points"`
+	FastestLapMaxPosition int       `json:"fastest_lap_max_position"`
+}
+
+// PointsSystemSavedDTO es la respuesta al guardar una tabla de puntos
+type PointsSystemSavedDTO struct {
+	PointsSystem       PointsSystemDTO `json:"points_system"`
+	SessionsRecomputed int             `json:"sessions_recomputed"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+import "time"
+
+// Agrupaciones de las estadísticas de resultados
+const (
+	StatsGroupDriver = "driver"
+	StatsGroupTeam   = "team"
+)
+
+// ResultStatsQueryDTO son los filtros de las estadísticas: sesiones que empezaron en [From, To)
+type ResultStatsQueryDTO struct {
+	GroupBy  string // driver o team
+	From     time.Time
+	To       time.Time
+	Session  string // race o sprint
+	DriverID int    // 0 = todos
+	TeamName string // "" = todos
+}
+
+// ResultStatsEntryDTO son los totales de un piloto o de un equipo en el rango
+type ResultStatsEntryDTO struct {
+	DriverID      int      `json:"driver_id,omitempty"`
+	FullName      string   `json:"full_name,omitempty"`
+	NameAcronym   string   `json:"name_acronym,omitempty"`
+	TeamName      string   `json:"team_name"`
+	Entries       int      `json:"entries"` // Resultados cargados (terminó o no)
+	Wins          int      `json:"wins"`
+	Podiums       int      `json:"podiums"`
+	Finishes      int      `json:"finishes"`
+	DNFs          int      `json:"dnfs"`
+	AverageFinish *float64 `json:"average_finish"` // nil si no terminó ninguna
+	DNFRate       float64  `json:"dnf_rate"`       // dnfs / entries
+}
+
+// ResultStatsDTO son las estadísticas de resultados de un rango de fechas
+type ResultStatsDTO struct {
+	GroupBy string                `json:"group_by"`
+	Session string                `json:"session"`
+	From    time.Time             `json:"from"`
+	To      time.Time             `json:"to"`
+	Stats   []ResultStatsEntryDTO `json:"stats"`
+}
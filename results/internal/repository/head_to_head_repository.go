@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"prediapp.local/db/model"
+	e "prediapp.local/results/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+// GetDriverWithTeammates obtiene un piloto y los demás pilotos de su equipo (según el equipo actual del piloto)
+func (r *resultRepository) GetDriverWithTeammates(ctx context.Context, driverID int) (*model.Driver, []*model.Driver, e.ApiError) {
+	var driver model.Driver
+	if err := r.db.WithContext(ctx).First(&driver, driverID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, e.NewNotFoundApiError("Driver not found")
+		}
+		return nil, nil, e.NewInternalServerApiError("Error fetching driver", err)
+	}
+
+	var teammates []*model.Driver
+	if driver.TeamName == "" {
+		return &driver, teammates, nil
+	}
+	if err := r.db.WithContext(ctx).
+		Where("team_name = ? AND id <> ?", driver.TeamName, driver.ID).
+		Order("id").
+		Find(&teammates).Error; err != nil {
+		return nil, nil, e.NewInternalServerApiError("Error fetching teammates", err)
+	}
+	return &driver, teammates, nil
+}
+
+// GetSeasonResultsForDrivers obtiene los resultados de una temporada de los pilotos indicados, con la sesión precargada
+func (r *resultRepository) GetSeasonResultsForDrivers(ctx context.Context, year int, driverIDs []int) ([]*model.Result, e.ApiError) {
+	var results []*model.Result
+	if err := r.db.WithContext(ctx).
+		Preload("Session").
+		Joins("JOIN sessions ON sessions.id = results.session_id").
+		Where("sessions.year = ? AND sessions.deleted_at IS NULL AND results.driver_id IN ?", year, driverIDs).
+		Order("sessions.date_start, results.session_id").
+		Find(&results).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error fetching season results for drivers", err)
+	}
+	return results, nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"prediapp.local/db/model"
+	e "prediapp.local/results/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type ingestionRepository struct {
+	db *gorm.DB
+}
+
+type IngestionRepository interface {
+	GetSessionsPendingIngestion(ctx context.Context, now time.Time, since time.Time, staleBefore time.Time) ([]*model.Session, e.ApiError)
+	GetSessionByID(ctx context.Context, sessionID int) (*model.Session, e.ApiError)
+	GetIngestionBySessionID(ctx context.Context, sessionID int) (*model.SessionIngestion, e.ApiError)
+	SaveIngestion(ctx context.Context, ingestion *model.SessionIngestion) e.ApiError
+	ListIngestions(ctx context.Context, status string) ([]*model.SessionIngestion, e.ApiError)
+}
+
+func NewIngestionRepository(db *gorm.DB) IngestionRepository {
+	return &ingestionRepository{db: db}
+}
+
+// GetSessionsPendingIngestion devuelve las sesiones terminadas entre since y now que todavía no tienen
+// resultados y cuya ingesta no terminó ni falló definitivamente (o tiene un reintento vencido).
+// También devuelve las ingestas que quedaron en running sin actualizarse desde staleBefore, que
+// corresponden a un intento cortado por una caída o reinicio del proceso
+func (r *ingestionRepository) GetSessionsPendingIngestion(ctx context.Context, now time.Time, since time.Time, staleBefore time.Time) ([]*model.Session, e.ApiError) {
+	var sessions []*model.Session
+	err := r.db.WithContext(ctx).
+		Joins("LEFT JOIN session_ingestions si ON si.session_id = sessions.id").
+		Where("sessions.date_end < ? AND sessions.date_end >= ?", now, since).
+		Where("NOT EXISTS (SELECT 1 FROM results WHERE results.session_id = sessions.id)").
+		Where("si.id IS NULL OR (si.status = ? AND (si.next_attempt_at IS NULL OR si.next_attempt_at <= ?)) OR (si.status = ? AND si.updated_at < ?)",
+			model.IngestionStatusPending, now, model.IngestionStatusRunning, staleBefore).
+		Order("sessions.date_end ASC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, e.NewInternalServerApiError("Error finding sessions pending ingestion", err)
+	}
+	return sessions, nil
+}
+
+// GetSessionByID obtiene la sesión a ingerir
+func (r *ingestionRepository) GetSessionByID(ctx context.Context, sessionID int) (*model.Session, e.ApiError) {
+	var session model.Session
+	if err := r.db.WithContext(ctx).First(&session, sessionID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, e.NewNotFoundApiError("Session not found")
+		}
+		return nil, e.NewInternalServerApiError("Error finding session", err)
+	}
+	return &session, nil
+}
+
+// GetIngestionBySessionID obtiene el estado de ingesta de una sesión
+func (r *ingestionRepository) GetIngestionBySessionID(ctx context.Context, sessionID int) (*model.SessionIngestion, e.ApiError) {
+	var ingestion model.SessionIngestion
+	if err := r.db.WithContext(ctx).Preload("Session").Where("session_id = ?", sessionID).First(&ingestion).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, e.NewNotFoundApiError("Ingestion not found for session")
+		}
+		return nil, e.NewInternalServerApiError("Error finding session ingestion", err)
+	}
+	return &ingestion, nil
+}
+
+// SaveIngestion crea o actualiza el estado de ingesta de una sesión
+func (r *ingestionRepository) SaveIngestion(ctx context.Context, ingestion *model.SessionIngestion) e.ApiError {
+	if err := r.db.WithContext(ctx).Omit("Session").Save(ingestion).Error; err != nil {
+		return e.NewInternalServerApiError("Error saving session ingestion", err)
+	}
+	return nil
+}
+
+// ListIngestions lista los estados de ingesta, opcionalmente filtrados por status
+func (r *ingestionRepository) ListIngestions(ctx context.Context, status string) ([]*model.SessionIngestion, e.ApiError) {
+	var ingestions []*model.SessionIngestion
+	query := r.db.WithContext(ctx).Preload("Session")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Order("updated_at DESC").Find(&ingestions).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error listing session ingestions", err)
+	}
+	return ingestions, nil
+}
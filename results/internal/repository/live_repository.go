@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"prediapp.local/db/model"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// GetLiveSessions devuelve las sesiones con session_key que están en curso, o que terminaron hace menos de grace
+// (el provider sigue informando posiciones un rato después de la bandera)
+func (r *resultRepository) GetLiveSessions(ctx context.Context, now time.Time, grace time.Duration) ([]*model.Session, e.ApiError) {
+	var sessions []*model.Session
+	if err := r.db.WithContext(ctx).
+		Where("date_start <= ? AND date_end >= ? AND session_key IS NOT NULL", now, now.Add(-grace)).
+		Order("date_start").
+		Find(&sessions).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error finding live sessions", err)
+	}
+	return sessions, nil
+}
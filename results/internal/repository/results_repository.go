@@ -3,11 +3,13 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"prediapp.local/db/model"
 	e "prediapp.local/results/pkg/utils"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type resultRepository struct {
@@ -22,13 +24,57 @@ type ResultRepository interface {
 	DeleteResult(ctx context.Context, resultID int) e.ApiError
 	GetResultsBySessionID(ctx context.Context, sessionID int) ([]*model.Result, e.ApiError)
 	GetResultsByDriverID(ctx context.Context, driverID int) ([]*model.Result, e.ApiError)
-	GetAllResults(ctx context.Context) ([]*model.Result, e.ApiError)
+	GetAllResults(ctx context.Context, offset, limit int) ([]*model.Result, e.ApiError)
 	GetFastestLapInSession(ctx context.Context, sessionID int) (*model.Result, e.ApiError)
 	// GetDriverPositionInSession(ctx context.Context, driverID int, sessionID int) (int, e.ApiError)
 	GetResultsOrderedByPosition(ctx context.Context, sessionID int) ([]*model.Result, e.ApiError)
 	ExistsSessionInResults(ctx context.Context, sessionID int) (bool, e.ApiError)
 	SessionCreateResultAdmin(ctx context.Context, results []*model.Result) error
 	SessionCreateOrUpdateResultsAdmin(ctx context.Context, resultsToCreate, resultsToUpdate []*model.Result) error
+	UpsertSessionResults(ctx context.Context, sessionID int, results []*model.Result) e.ApiError
+
+	// Ciclo de vida y enmiendas
+	GetSessionResultStatus(ctx context.Context, sessionID int) (*model.SessionResultStatus, e.ApiError)
+	EnsureProvisionalStatus(ctx context.Context, sessionID int) e.ApiError
+	SaveSessionResultStatus(ctx context.Context, status *model.SessionResultStatus) e.ApiError
+	AmendResults(ctx context.Context, results []*model.Result, amendments []*model.ResultAmendment, status *model.SessionResultStatus) e.ApiError
+	GetAmendmentsBySession(ctx context.Context, sessionID int) ([]*model.ResultAmendment, e.ApiError)
+
+	// Vueltas
+	ReplaceSessionLaps(ctx context.Context, sessionID int, laps []*model.Lap) e.ApiError
+	GetSessionLaps(ctx context.Context, sessionID int) ([]*model.Lap, e.ApiError)
+	GetDriverLaps(ctx context.Context, sessionID, driverID int) ([]*model.Lap, e.ApiError)
+
+	// Paradas en boxes
+	ReplaceSessionPitStops(ctx context.Context, sessionID int, pitStops []*model.PitStop) e.ApiError
+	GetSessionPitStops(ctx context.Context, sessionID int) ([]*model.PitStop, e.ApiError)
+
+	// Puntos del campeonato y posiciones
+	GetPointsSystem(ctx context.Context, year int, sessionKind string) (*model.PointsSystem, e.ApiError)
+	GetPointsSystems(ctx context.Context) ([]*model.PointsSystem, e.ApiError)
+	SavePointsSystem(ctx context.Context, system *model.PointsSystem) e.ApiError
+	UpdateSessionPoints(ctx context.Context, sessionID int, pointsByResult map[int]float64) e.ApiError
+	GetSessionIDsWithResultsSince(ctx context.Context, year int) ([]int, e.ApiError)
+	GetDriverStandings(ctx context.Context, year int) ([]DriverStandingRow, e.ApiError)
+	GetConstructorStandings(ctx context.Context, year int) ([]ConstructorStandingRow, e.ApiError)
+
+	// Estadísticas agregadas (se calculan en la base)
+	GetDriverResultStats(ctx context.Context, filter ResultStatsFilter) ([]ResultStatsRow, e.ApiError)
+	GetTeamResultStats(ctx context.Context, filter ResultStatsFilter) ([]ResultStatsRow, e.ApiError)
+
+	// Sesiones en vivo
+	GetLiveSessions(ctx context.Context, now time.Time, grace time.Duration) ([]*model.Session, e.ApiError)
+
+	// Comparación entre compañeros de equipo
+	GetDriverWithTeammates(ctx context.Context, driverID int) (*model.Driver, []*model.Driver, e.ApiError)
+	GetSeasonResultsForDrivers(ctx context.Context, year int, driverIDs []int) ([]*model.Result, e.ApiError)
+
+	// Props de carrera
+	GetActiveProps(ctx context.Context) ([]*model.Prop, e.ApiError)
+	GetPropByID(ctx context.Context, propID int) (*model.Prop, e.ApiError)
+	SaveSessionPropResults(ctx context.Context, sessionID int, propResults []*model.SessionPropResult) e.ApiError
+	GetSessionPropResults(ctx context.Context, sessionID int) ([]*model.SessionPropResult, e.ApiError)
+	GetSessionWeather(ctx context.Context, sessionID int) ([]*model.WeatherSample, e.ApiError)
 }
 
 func NewResultRepository(db *gorm.DB) ResultRepository {
@@ -103,10 +149,14 @@ func (r *resultRepository) GetResultsByDriverID(ctx context.Context, driverID in
 	return results, nil
 }
 
-// GetAllResults obtiene todos los resultados de la base de datos
-func (r *resultRepository) GetAllResults(ctx context.Context) ([]*model.Result, e.ApiError) {
+// GetAllResults obtiene una página de resultados, del más reciente al más viejo
+func (r *resultRepository) GetAllResults(ctx context.Context, offset, limit int) ([]*model.Result, e.ApiError) {
 	var results []*model.Result
-	if err := r.db.WithContext(ctx).Preload("Driver").Preload("Session").Find(&results).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Driver").Preload("Session").
+		Order("id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&results).Error; err != nil {
 		return nil, e.NewInternalServerApiError("Error finding all results", err)
 	}
 	return results, nil
@@ -190,3 +240,249 @@ func (r *resultRepository) SessionCreateOrUpdateResultsAdmin(ctx context.Context
 		return nil
 	})
 }
+
+// UpsertSessionResults guarda en una sola transacción los resultados de una sesión:
+// actualiza los que ya existen para (driver, session), crea el resto en lote y borra los de pilotos
+// que ya no figuran en la clasificación. Los resultados quedan con ID, CreatedAt y UpdatedAt completos.
+func (r *resultRepository) UpsertSessionResults(ctx context.Context, sessionID int, results []*model.Result) e.ApiError {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []*model.Result
+		if err := tx.Where("session_id = ?", sessionID).Find(&existing).Error; err != nil {
+			return err
+		}
+		existingByDriver := make(map[int]*model.Result, len(existing))
+		for _, result := range existing {
+			existingByDriver[result.DriverID] = result
+		}
+
+		var toCreate []*model.Result
+		incoming := make(map[int]bool, len(results))
+		for _, result := range results {
+			result.SessionID = sessionID
+			incoming[result.DriverID] = true
+			current, ok := existingByDriver[result.DriverID]
+			if !ok {
+				toCreate = append(toCreate, result)
+				continue
+			}
+			result.ID = current.ID
+			result.CreatedAt = current.CreatedAt
+			columns := []string{"position", "status", "fastest_lap_time", "grid_position", "laps_completed",
+				"total_time", "gap_to_leader", "interval_to_ahead", "laps_behind", "q1_time", "q2_time", "q3_time", "knockout_segment"}
+			if err := tx.Model(current).Select(columns).Updates(result).Error; err != nil {
+				return err
+			}
+		}
+
+		var staleIDs []int
+		for _, result := range existing {
+			if !incoming[result.DriverID] {
+				staleIDs = append(staleIDs, result.ID)
+			}
+		}
+		if len(staleIDs) > 0 {
+			if err := tx.Where("id IN ?", staleIDs).Delete(&model.Result{}).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(toCreate) > 0 {
+			if err := tx.Create(&toCreate).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return e.NewInternalServerApiError("Error saving session results", err)
+	}
+	return nil
+}
+
+// GetActiveProps obtiene los props de carrera habilitados
+func (r *resultRepository) GetActiveProps(ctx context.Context) ([]*model.Prop, e.ApiError) {
+	var props []*model.Prop
+	if err := r.db.WithContext(ctx).Where("active = ?", true).Order("id").Find(&props).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error fetching active props", err)
+	}
+	return props, nil
+}
+
+func (r *resultRepository) GetPropByID(ctx context.Context, propID int) (*model.Prop, e.ApiError) {
+	var prop model.Prop
+	if err := r.db.WithContext(ctx).First(&prop, propID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("Prop no encontrado")
+		}
+		return nil, e.NewInternalServerApiError("Error fetching prop", err)
+	}
+	return &prop, nil
+}
+
+// SaveSessionPropResults guarda (o reemplaza) los valores reales de los props de una sesión
+func (r *resultRepository) SaveSessionPropResults(ctx context.Context, sessionID int, propResults []*model.SessionPropResult) e.ApiError {
+	if len(propResults) == 0 {
+		return nil
+	}
+	for _, pr := range propResults {
+		pr.SessionID = sessionID
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "session_id"}, {Name: "prop_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"answer", "manual", "updated_at"}),
+	}).Create(&propResults).Error; err != nil {
+		return e.NewInternalServerApiError("Error saving session prop results", err)
+	}
+	return nil
+}
+
+// GetSessionPropResults obtiene los props resueltos de una sesión, con la definición del prop
+func (r *resultRepository) GetSessionPropResults(ctx context.Context, sessionID int) ([]*model.SessionPropResult, e.ApiError) {
+	var propResults []*model.SessionPropResult
+	if err := r.db.WithContext(ctx).Preload("Prop").Where("session_id = ?", sessionID).Find(&propResults).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error fetching session prop results", err)
+	}
+	return propResults, nil
+}
+
+// GetSessionWeather obtiene las mediciones de clima de una sesión cargadas por el microservicio de sessions
+func (r *resultRepository) GetSessionWeather(ctx context.Context, sessionID int) ([]*model.WeatherSample, e.ApiError) {
+	var samples []*model.WeatherSample
+	if err := r.db.WithContext(ctx).Where("session_id = ?", sessionID).Order("date").Find(&samples).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error fetching session weather", err)
+	}
+	return samples, nil
+}
+
+// GetSessionResultStatus obtiene el estado (provisional/official/amended) de los resultados de una sesión
+func (r *resultRepository) GetSessionResultStatus(ctx context.Context, sessionID int) (*model.SessionResultStatus, e.ApiError) {
+	var status model.SessionResultStatus
+	if err := r.db.WithContext(ctx).Where("session_id = ?", sessionID).First(&status).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, e.NewNotFoundApiError("Result status not found for session")
+		}
+		return nil, e.NewInternalServerApiError("Error finding session result status", err)
+	}
+	return &status, nil
+}
+
+// EnsureProvisionalStatus registra la sesión como provisional si todavía no tiene estado
+func (r *resultRepository) EnsureProvisionalStatus(ctx context.Context, sessionID int) e.ApiError {
+	status := model.SessionResultStatus{SessionID: sessionID, Status: model.ResultStatusProvisional}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&status).Error; err != nil {
+		return e.NewInternalServerApiError("Error creating session result status", err)
+	}
+	return nil
+}
+
+// SaveSessionResultStatus crea o actualiza el estado de los resultados de una sesión
+func (r *resultRepository) SaveSessionResultStatus(ctx context.Context, status *model.SessionResultStatus) e.ApiError {
+	if err := r.db.WithContext(ctx).Omit("Session").Save(status).Error; err != nil {
+		return e.NewInternalServerApiError("Error saving session result status", err)
+	}
+	return nil
+}
+
+// AmendResults aplica una enmienda en una transacción: actualiza los resultados, guarda las enmiendas y el nuevo estado de la sesión
+func (r *resultRepository) AmendResults(ctx context.Context, results []*model.Result, amendments []*model.ResultAmendment, status *model.SessionResultStatus) e.ApiError {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, result := range results {
+			if err := tx.Model(result).Select("position", "status", "fastest_lap_time").Updates(result).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Omit("Result").Create(&amendments).Error; err != nil {
+			return err
+		}
+		return tx.Omit("Session").Save(status).Error
+	})
+	if err != nil {
+		return e.NewInternalServerApiError("Error amending results", err)
+	}
+	return nil
+}
+
+// GetAmendmentsBySession devuelve el historial de enmiendas de una sesión, de la más vieja a la más nueva
+func (r *resultRepository) GetAmendmentsBySession(ctx context.Context, sessionID int) ([]*model.ResultAmendment, e.ApiError) {
+	var amendments []*model.ResultAmendment
+	if err := r.db.WithContext(ctx).Where("session_id = ?", sessionID).Order("created_at ASC, id ASC").Find(&amendments).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error finding result amendments", err)
+	}
+	return amendments, nil
+}
+
+// ReplaceSessionLaps reemplaza todas las vueltas de una sesión por las recibidas, en una sola transacción
+func (r *resultRepository) ReplaceSessionLaps(ctx context.Context, sessionID int, laps []*model.Lap) e.ApiError {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("session_id = ?", sessionID).Delete(&model.Lap{}).Error; err != nil {
+			return err
+		}
+		if len(laps) == 0 {
+			return nil
+		}
+		for _, lap := range laps {
+			lap.SessionID = sessionID
+		}
+		return tx.CreateInBatches(laps, 500).Error
+	})
+	if err != nil {
+		return e.NewInternalServerApiError("Error saving session laps", err)
+	}
+	return nil
+}
+
+// GetSessionLaps obtiene las vueltas de todos los pilotos de una sesión, con el piloto precargado
+func (r *resultRepository) GetSessionLaps(ctx context.Context, sessionID int) ([]*model.Lap, e.ApiError) {
+	var laps []*model.Lap
+	if err := r.db.WithContext(ctx).Preload("Driver").
+		Where("session_id = ?", sessionID).
+		Order("driver_id, lap_number").
+		Find(&laps).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error fetching session laps", err)
+	}
+	return laps, nil
+}
+
+// GetDriverLaps obtiene las vueltas de un piloto en una sesión ordenadas por número de vuelta
+func (r *resultRepository) GetDriverLaps(ctx context.Context, sessionID, driverID int) ([]*model.Lap, e.ApiError) {
+	var laps []*model.Lap
+	if err := r.db.WithContext(ctx).Preload("Driver").
+		Where("session_id = ? AND driver_id = ?", sessionID, driverID).
+		Order("lap_number").
+		Find(&laps).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error fetching driver laps", err)
+	}
+	return laps, nil
+}
+
+// ReplaceSessionPitStops reemplaza todas las paradas en boxes de una sesión por las recibidas, en una sola transacción
+func (r *resultRepository) ReplaceSessionPitStops(ctx context.Context, sessionID int, pitStops []*model.PitStop) e.ApiError {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("session_id = ?", sessionID).Delete(&model.PitStop{}).Error; err != nil {
+			return err
+		}
+		if len(pitStops) == 0 {
+			return nil
+		}
+		for _, pitStop := range pitStops {
+			pitStop.SessionID = sessionID
+		}
+		return tx.Create(&pitStops).Error
+	})
+	if err != nil {
+		return e.NewInternalServerApiError("Error saving session pit stops", err)
+	}
+	return nil
+}
+
+// GetSessionPitStops obtiene las paradas en boxes de una sesión en orden cronológico, con el piloto precargado
+func (r *resultRepository) GetSessionPitStops(ctx context.Context, sessionID int) ([]*model.PitStop, e.ApiError) {
+	var pitStops []*model.PitStop
+	if err := r.db.WithContext(ctx).Preload("Driver").
+		Where("session_id = ?", sessionID).
+		Order("lap_number, date, driver_id").
+		Find(&pitStops).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error fetching session pit stops", err)
+	}
+	return pitStops, nil
+}
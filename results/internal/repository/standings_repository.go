@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"prediapp.local/db/model"
+	e "prediapp.local/results/pkg/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DriverStandingRow es la suma de puntos de un piloto en una temporada
+type DriverStandingRow struct {
+	DriverID    int
+	FullName    string
+	NameAcronym string
+	TeamName    string
+	Points      float64
+	Wins        int
+	Podiums     int
+	Races       int
+}
+
+// ConstructorStandingRow es la suma de puntos de un equipo en una temporada.
+// El equipo de cada resultado es el equipo actual del piloto.
+type ConstructorStandingRow struct {
+	TeamName string
+	Points   float64
+	Wins     int
+	Podiums  int
+}
+
+// GetPointsSystem obtiene la tabla de puntos vigente para la temporada: la del año más reciente que no la supere
+func (r *resultRepository) GetPointsSystem(ctx context.Context, year int, sessionKind string) (*model.PointsSystem, e.ApiError) {
+	var system model.PointsSystem
+	err := r.db.WithContext(ctx).
+		Where("session_kind = ? AND year <= ?", sessionKind, year).
+		Order("year DESC").
+		First(&system).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, e.NewNotFoundApiError("Points system not found")
+		}
+		return nil, e.NewInternalServerApiError("Error fetching points system", err)
+	}
+	return &system, nil
+}
+
+// GetPointsSystems obtiene todas las tablas de puntos configuradas
+func (r *resultRepository) GetPointsSystems(ctx context.Context) ([]*model.PointsSystem, e.ApiError) {
+	var systems []*model.PointsSystem
+	if err := r.db.WithContext(ctx).Order("session_kind, year").Find(&systems).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error fetching points systems", err)
+	}
+	return systems, nil
+}
+
+// SavePointsSystem crea o reemplaza la tabla de puntos de una temporada y tipo de sesión
+func (r *resultRepository) SavePointsSystem(ctx context.Context, system *model.PointsSystem) e.ApiError {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "year"}, {Name: "session_kind"}},
+		DoUpdates: clause.AssignmentColumns([]string{"points", "fastest_lap_points", "fastest_lap_max_position", "updated_at"}),
+	}).Create(system).Error
+	if err != nil {
+		return e.NewInternalServerApiError("Error saving points system", err)
+	}
+	return nil
+}
+
+// UpdateSessionPoints pone en cero los puntos de la sesión y asigna los recibidos (resultID -> puntos)
+func (r *resultRepository) UpdateSessionPoints(ctx context.Context, sessionID int, pointsByResult map[int]float64) e.ApiError {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Result{}).Where("session_id = ?", sessionID).Update("points", 0).Error; err != nil {
+			return err
+		}
+		for resultID, points := range pointsByResult {
+			if err := tx.Model(&model.Result{}).Where("id = ?", resultID).Update("points", points).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return e.NewInternalServerApiError("Error updating session points", err)
+	}
+	return nil
+}
+
+// GetSessionIDsWithResultsSince obtiene las sesiones con resultados desde la temporada indicada
+func (r *resultRepository) GetSessionIDsWithResultsSince(ctx context.Context, year int) ([]int, e.ApiError) {
+	var sessionIDs []int
+	err := r.db.WithContext(ctx).
+		Table("results").
+		Distinct("results.session_id").
+		Joins("JOIN sessions ON sessions.id = results.session_id").
+		Where("sessions.year >= ? AND sessions.deleted_at IS NULL", year).
+		Pluck("results.session_id", &sessionIDs).Error
+	if err != nil {
+		return nil, e.NewInternalServerApiError("Error fetching sessions with results", err)
+	}
+	return sessionIDs, nil
+}
+
+// GetDriverStandings suma los puntos de cada piloto en la temporada. Victorias y podios cuentan solo en carreras.
+func (r *resultRepository) GetDriverStandings(ctx context.Context, year int) ([]DriverStandingRow, e.ApiError) {
+	var rows []DriverStandingRow
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT r.driver_id, d.full_name, d.name_acronym, d.team_name,
+			COALESCE(SUM(r.points), 0) AS points,
+			SUM(CASE WHEN s.session_name = 'Race' AND r.position = 1 THEN 1 ELSE 0 END) AS wins,
+			SUM(CASE WHEN s.session_name = 'Race' AND r.position <= 3 THEN 1 ELSE 0 END) AS podiums,
+			SUM(CASE WHEN s.session_name = 'Race' THEN 1 ELSE 0 END) AS races
+		FROM results r
+		JOIN sessions s ON s.id = r.session_id
+		JOIN drivers d ON d.id = r.driver_id
+		WHERE s.year = ? AND s.deleted_at IS NULL AND s.session_name IN ('Race', 'Sprint')
+		GROUP BY r.driver_id, d.full_name, d.name_acronym, d.team_name`, year).Scan(&rows).Error
+	if err != nil {
+		return nil, e.NewInternalServerApiError("Error computing driver standings", err)
+	}
+	return rows, nil
+}
+
+// GetConstructorStandings suma los puntos de los pilotos de cada equipo en la temporada
+func (r *resultRepository) GetConstructorStandings(ctx context.Context, year int) ([]ConstructorStandingRow, e.ApiError) {
+	var rows []ConstructorStandingRow
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT d.team_name,
+			COALESCE(SUM(r.points), 0) AS points,
+			SUM(CASE WHEN s.session_name = 'Race' AND r.position = 1 THEN 1 ELSE 0 END) AS wins,
+			SUM(CASE WHEN s.session_name = 'Race' AND r.position <= 3 THEN 1 ELSE 0 END) AS podiums
+		FROM results r
+		JOIN sessions s ON s.id = r.session_id
+		JOIN drivers d ON d.id = r.driver_id
+		WHERE s.year = ? AND s.deleted_at IS NULL AND s.session_name IN ('Race', 'Sprint')
+		GROUP BY d.team_name`, year).Scan(&rows).Error
+	if err != nil {
+		return nil, e.NewInternalServerApiError("Error computing constructor standings", err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	e "prediapp.local/results/pkg/utils"
+)
+
+// ResultStatsFilter acota las estadísticas: sesiones de un tipo (por nombre) que empezaron en [From, To).
+// DriverID y TeamName en cero no filtran.
+type ResultStatsFilter struct {
+	From        time.Time
+	To          time.Time
+	SessionName string
+	DriverID    int
+	TeamName    string
+}
+
+// ResultStatsRow son los totales de un piloto o de un equipo. En las filas por equipo DriverID, FullName y
+// NameAcronym quedan vacíos. El equipo de cada resultado es el equipo actual del piloto.
+type ResultStatsRow struct {
+	DriverID      int
+	FullName      string
+	NameAcronym   string
+	TeamName      string
+	Entries       int
+	Wins          int
+	Podiums       int
+	Finishes      int
+	DNFs          int      `gorm:"column:dnfs"`
+	AverageFinish *float64 // Promedio de la posición de llegada entre los que terminaron
+}
+
+// resultStatsColumns son los agregados comunes a pilotos y equipos
+const resultStatsColumns = `
+	COUNT(*) AS entries,
+	SUM(CASE WHEN r.status = 'FINISHED' AND r.position = 1 THEN 1 ELSE 0 END) AS wins,
+	SUM(CASE WHEN r.status = 'FINISHED' AND r.position <= 3 THEN 1 ELSE 0 END) AS podiums,
+	SUM(CASE WHEN r.status = 'FINISHED' THEN 1 ELSE 0 END) AS finishes,
+	SUM(CASE WHEN r.status = 'DNF' THEN 1 ELSE 0 END) AS dnfs,
+	AVG(CASE WHEN r.status = 'FINISHED' THEN r.position END) AS average_finish`
+
+// GetDriverResultStats calcula victorias, podios, llegadas, abandonos y llegada promedio de cada piloto
+func (r *resultRepository) GetDriverResultStats(ctx context.Context, filter ResultStatsFilter) ([]ResultStatsRow, e.ApiError) {
+	where, args := resultStatsWhere(filter)
+	var rows []ResultStatsRow
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT r.driver_id, d.full_name, d.name_acronym, d.team_name,`+resultStatsColumns+`
+		FROM results r
+		JOIN sessions s ON s.id = r.session_id
+		JOIN drivers d ON d.id = r.driver_id
+		WHERE `+where+`
+		GROUP BY r.driver_id, d.full_name, d.name_acronym, d.team_name`, args...).Scan(&rows).Error
+	if err != nil {
+		return nil, e.NewInternalServerApiError("Error computing driver result stats", err)
+	}
+	return rows, nil
+}
+
+// GetTeamResultStats calcula los mismos totales agrupando los resultados de los pilotos de cada equipo
+func (r *resultRepository) GetTeamResultStats(ctx context.Context, filter ResultStatsFilter) ([]ResultStatsRow, e.ApiError) {
+	where, args := resultStatsWhere(filter)
+	var rows []ResultStatsRow
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT d.team_name,`+resultStatsColumns+`
+		FROM results r
+		JOIN sessions s ON s.id = r.session_id
+		JOIN drivers d ON d.id = r.driver_id
+		WHERE `+where+`
+		GROUP BY d.team_name`, args...).Scan(&rows).Error
+	if err != nil {
+		return nil, e.NewInternalServerApiError("Error computing team result stats", err)
+	}
+	return rows, nil
+}
+
+// resultStatsWhere arma la condición del filtro; usa el índice de sessions por (session_name, date_start)
+func resultStatsWhere(filter ResultStatsFilter) (string, []interface{}) {
+	conditions := []string{"s.deleted_at IS NULL", "s.session_name = ?", "s.date_start >= ?", "s.date_start < ?"}
+	args := []interface{}{filter.SessionName, filter.From, filter.To}
+	if filter.DriverID != 0 {
+		conditions = append(conditions, "r.driver_id = ?")
+		args = append(args, filter.DriverID)
+	}
+	if filter.TeamName != "" {
+		conditions = append(conditions, "d.team_name = ?")
+		args = append(args, filter.TeamName)
+	}
+	return strings.Join(conditions, " AND "), args
+}
@@ -6,20 +6,64 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func MapUrls(engine *gin.Engine, resultController *api.ResultController) {
+func MapUrls(engine *gin.Engine, resultController *api.ResultController, ingestionController *api.IngestionController) {
 	// Rutas relacionadas con resultados
 	engine.GET("/results/api/:sessionId", resultController.FetchResultsFromExternalAPI) // Obtener resultados de la API externa para una sesión
 	engine.GET("/results/session/api/:sessionId", resultController.FetchNonRaceSessionResults)
 	engine.POST("/results", resultController.CreateResult)                                         // Crear un nuevo resultado
-	engine.POST("/results/admin", resultController.CreateSessionResultsAdmin)                      // Crear los resultados de una session CUALQUIERA siendo ADMIN
+	engine.POST("/results/admin", resultController.CreateSessionResultsAdmin)                      // Crear los resultados de una session CUALQUIERA siendo ADMIN (?dry_run=true devuelve el diff sin guardar)
+	engine.POST("/results/admin/import", resultController.ImportSessionResults)                    // Importar resultados desde un CSV/JSON con reporte de validación
 	engine.PUT("/results/:id", resultController.UpdateResult)                                      // Actualizar un resultado
 	engine.DELETE("/results/:id", resultController.DeleteResult)                                   // Eliminar un resultado por su ID
 	engine.GET("/results/session/:sessionID", resultController.GetResultsOrderedByPosition)        // Obtener resultados de una sesión ordenados por posición
 	engine.GET("/results/session/:sessionID/fastest-lap", resultController.GetFastestLapInSession) // Obtener la vuelta más rápida en una sesión
-	engine.GET("/results", resultController.GetAllResults)                                         // Obtener todos los resultados
+	engine.GET("/results", resultController.GetAllResults)                                         // Obtener una página de resultados (?offset=&limit=)
 	engine.GET("/results/session/:sessionID/top/:n", resultController.GetTopNDriversInSession)     // Obtener los mejores N pilotos de una sesión
 	engine.DELETE("/results/session/:sessionID", resultController.DeleteAllResultsForSession)      // Eliminar todos los resultados de una sesión
 
+	// Rutas relacionadas con props de carrera
+	engine.POST("/results/session/:sessionID/props/resolve", resultController.ResolveSessionProps) // Calcular los valores reales de los props
+	engine.GET("/results/session/:sessionID/props", resultController.GetSessionPropResults)        // Obtener los props resueltos de una sesión
+	engine.PUT("/results/session/:sessionID/props/:propID", resultController.SetSessionPropAnswer) // Cargar a mano el valor real de un prop (admin)
+
+	// Rutas del ciclo de vida de los resultados (provisional -> official -> amended)
+	engine.GET("/results/session/:sessionID/status", resultController.GetSessionResultStatus)   // Estado de los resultados de una sesión
+	engine.POST("/results/session/:sessionID/official", resultController.MarkSessionOfficial)   // Confirmar resultados y puntuar prodes
+	engine.POST("/results/:id/amendments", resultController.AmendResult)                        // Enmendar un resultado con motivo
+	engine.POST("/results/session/:sessionID/amendments", resultController.AmendSessionResults) // Enmendar varios resultados de una sesión a la vez
+	engine.GET("/results/session/:sessionID/amendments", resultController.GetSessionAmendments) // Historial de enmiendas de una sesión
+
+	// Rutas de vueltas
+	engine.GET("/results/session/:sessionID/laps", resultController.GetSessionLaps)                     // Vueltas de todos los pilotos de una sesión
+	engine.GET("/results/session/:sessionID/laps/driver/:driverID", resultController.GetDriverLapChart) // Vueltas de un piloto en una sesión
+
+	// Rutas de paradas en boxes
+	engine.GET("/results/session/:sessionID/pitstops", resultController.GetSessionPitStops) // Paradas de una sesión y promedio por equipo
+
+	// Rutas de la clasificación por tandas
+	engine.GET("/results/session/:sessionID/qualifying", resultController.GetQualifyingSheet) // Hoja de tiempos Q1/Q2/Q3 de una clasificación
+
+	// Rutas de posiciones en vivo
+	engine.GET("/results/session/:sessionID/live", resultController.GetLiveStandings) // Posiciones provisorias de una sesión en curso
+
+	// Rutas del campeonato
+	engine.GET("/results/standings/drivers", resultController.GetDriverStandings)           // Campeonato de pilotos (?year=)
+	engine.GET("/results/standings/constructors", resultController.GetConstructorStandings) // Campeonato de constructores (?year=)
+	engine.GET("/results/admin/points-systems", resultController.GetPointsSystems)          // Tablas de puntos por temporada
+	engine.PUT("/results/admin/points-systems", resultController.SavePointsSystem)          // Crear o reemplazar una tabla de puntos
+
+	// Rutas de estadísticas agregadas
+	engine.GET("/results/stats/drivers", resultController.GetDriverResultStats) // Victorias, podios, llegada promedio y abandonos por piloto (?from=&to=&session=)
+	engine.GET("/results/stats/teams", resultController.GetTeamResultStats)     // Lo mismo por equipo
+
+	// Rutas de comparación entre compañeros de equipo
+	engine.GET("/results/drivers/:driverID/head-to-head", resultController.GetHeadToHead) // Mano a mano con los compañeros de equipo (?year=)
+
+	// Rutas de la ingesta automática de resultados (admin)
+	engine.GET("/results/admin/ingestion", ingestionController.ListIngestions)                   // Estado de ingesta de las sesiones (?status=)
+	engine.GET("/results/admin/ingestion/:sessionID", ingestionController.GetIngestionStatus)    // Estado de ingesta de una sesión
+	engine.POST("/results/admin/ingestion/:sessionID/retry", ingestionController.RetryIngestion) // Forzar un nuevo intento de carga
+
 	// Ruta para verificar si el servidor está en funcionamiento
 	engine.GET("/ping", func(c *gin.Context) {
 		c.String(200, "pong")
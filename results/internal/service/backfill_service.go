@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"prediapp.local/racedata"
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// BackfillSeason recorre todas las sesiones de una temporada en el provider y crea lo que falte: el fin de semana,
+// la sesión, los pilotos y los resultados. Es idempotente: las sesiones que ya tienen resultados se saltean (salvo
+// force), así que si se corta se puede volver a correr y retoma donde quedó.
+func (s *resultService) BackfillSeason(ctx context.Context, year int, force bool) (dto.BackfillReportDTO, e.ApiError) {
+	// 1. Sesiones de la temporada en el provider, en orden cronológico
+	providerSessions, err := s.raceData.GetSessions(racedata.SessionFilter{Year: year})
+	if err != nil {
+		return dto.BackfillReportDTO{}, e.NewInternalServerApiError("Error obteniendo las sesiones del provider", err)
+	}
+	sort.SliceStable(providerSessions, func(i, j int) bool {
+		return providerSessions[i].DateStart.Before(providerSessions[j].DateStart)
+	})
+
+	// 2. Sesiones ya cargadas, por session_key y por fin de semana + nombre
+	existing, err := s.sessionsClient.GetSessionsByYear(year)
+	if err != nil {
+		return dto.BackfillReportDTO{}, e.NewInternalServerApiError("Error obteniendo las sesiones cargadas", err)
+	}
+	byKey := make(map[int]dto.SessionSummaryDTO)
+	byName := make(map[string]dto.SessionSummaryDTO)
+	for _, session := range existing {
+		if session.SessionKey != nil {
+			byKey[*session.SessionKey] = session
+		}
+		byName[backfillSessionName(session.Location, session.SessionName, session.SessionType)] = session
+	}
+
+	// 3. Fines de semana ya cargados, por meeting_key
+	meetings, err := s.sessionsClient.GetMeetingsByYear(year)
+	if err != nil {
+		return dto.BackfillReportDTO{}, e.NewInternalServerApiError("Error obteniendo los fines de semana cargados", err)
+	}
+	meetingsByKey := make(map[int]int)
+	for _, meeting := range meetings {
+		if meeting.MeetingKey != nil {
+			meetingsByKey[*meeting.MeetingKey] = meeting.ID
+		}
+	}
+
+	// 4. Cada sesión por separado: un error no corta el backfill
+	report := dto.BackfillReportDTO{Year: year, SessionsFound: len(providerSessions)}
+	for i, providerSession := range providerSessions {
+		if ctx.Err() != nil {
+			break
+		}
+		entry := s.backfillSession(ctx, providerSession, byKey, byName, meetingsByKey, force)
+		log.Printf("Backfill %d: [%d/%d] %s %s: %s %s", year, i+1, len(providerSessions),
+			entry.Location, entry.SessionName, entry.Status, entry.Error)
+
+		report.Sessions = append(report.Sessions, entry)
+		if entry.SessionCreated {
+			report.SessionsCreated++
+		}
+		report.DriversCreated += entry.DriversCreated
+		switch entry.Status {
+		case dto.BackfillStatusIngested:
+			report.SessionsIngested++
+		case dto.BackfillStatusSkipped:
+			report.SessionsSkipped++
+		case dto.BackfillStatusFailed:
+			report.SessionsFailed++
+		}
+	}
+	return report, nil
+}
+
+// backfillSession crea la sesión y los pilotos que falten y carga los resultados de una sesión del provider
+func (s *resultService) backfillSession(ctx context.Context, providerSession racedata.Session,
+	byKey map[int]dto.SessionSummaryDTO, byName map[string]dto.SessionSummaryDTO, meetingsByKey map[int]int, force bool) dto.BackfillSessionReportDTO {
+
+	entry := dto.BackfillSessionReportDTO{
+		SessionKey:  providerSession.SessionKey,
+		Location:    providerSession.Location,
+		SessionName: providerSession.SessionName,
+	}
+	fail := func(message string) dto.BackfillSessionReportDTO {
+		entry.Status = dto.BackfillStatusFailed
+		entry.Error = message
+		return entry
+	}
+	if providerSession.DateEnd.After(time.Now().UTC()) {
+		entry.Status = dto.BackfillStatusNotFinished
+		return entry
+	}
+
+	// 1. Sesión: se busca por session_key y si no por fin de semana + nombre (cargada a mano, sin session_key)
+	session, found := byKey[providerSession.SessionKey]
+	if !found {
+		session, found = byName[backfillSessionName(providerSession.Location, providerSession.SessionName, providerSession.SessionType)]
+		if found {
+			if err := s.sessionsClient.UpdateSessionKey(session.ID, providerSession.SessionKey); err != nil {
+				return fail(err.Error())
+			}
+		}
+	}
+	if !found {
+		meetingID, err := s.backfillMeeting(providerSession, meetingsByKey)
+		if err != nil {
+			return fail(err.Error())
+		}
+		sessionKey := providerSession.SessionKey
+		created, err := s.sessionsClient.CreateSession(dto.CreateSessionRequestDTO{
+			WeekendID:        meetingID,
+			CircuitKey:       providerSession.CircuitKey,
+			CircuitShortName: providerSession.CircuitShortName,
+			CountryCode:      providerSession.CountryCode,
+			CountryName:      providerSession.CountryName,
+			DateStart:        providerSession.DateStart.UTC(),
+			DateEnd:          providerSession.DateEnd.UTC(),
+			Location:         providerSession.Location,
+			SessionKey:       &sessionKey,
+			SessionName:      providerSession.SessionName,
+			SessionType:      providerSession.SessionType,
+			Year:             providerSession.Year,
+		})
+		if err != nil {
+			return fail(err.Error())
+		}
+		session = created
+		entry.SessionCreated = true
+		byKey[providerSession.SessionKey] = created
+	}
+	entry.SessionID = session.ID
+
+	// 2. Si ya tiene resultados quedó completa en una corrida anterior (o se cargó a mano).
+	//    Con force se vuelven a cargar, pero solo si siguen provisionales: los oficiales se corrigen con una enmienda
+	if !force {
+		exists, apiErr := s.resultRepo.ExistsSessionInResults(ctx, session.ID)
+		if apiErr != nil {
+			return fail(apiErr.Message())
+		}
+		if exists {
+			entry.Status = dto.BackfillStatusSkipped
+			return entry
+		}
+	} else if apiErr := s.checkResultsEditable(ctx, session.ID); apiErr != nil {
+		if apiErr.Status() != http.StatusConflict {
+			return fail(apiErr.Message())
+		}
+		entry.Status = dto.BackfillStatusSkipped
+		entry.Error = "los resultados son oficiales, -force solo recarga sesiones provisionales"
+		return entry
+	}
+
+	// 3. Pilotos que corrieron la sesión. La ingesta los busca por número en la caché, así que se deja
+	//    cargado el piloto de esta temporada: un número reutilizado no tiene que apuntar al piloto actual
+	drivers, err := s.raceData.GetDrivers(providerSession.SessionKey)
+	if err != nil {
+		return fail(fmt.Sprintf("error obteniendo los pilotos de la sesión: %v", err))
+	}
+	for _, driver := range drivers {
+		matched, created, err := s.backfillDriver(driver, providerSession.Year)
+		if err != nil {
+			return fail(err.Error())
+		}
+		s.driverCache.Set(strconv.Itoa(driver.DriverNumber), matched, driverCacheTTL)
+		if created {
+			entry.DriversCreated++
+		}
+	}
+
+	// 4. Resultados, con la misma ingesta que usa el job automático
+	var results []dto.ResponseResultDTO
+	var apiErr e.ApiError
+	if strings.EqualFold(session.SessionName, "race") || strings.EqualFold(session.SessionType, "race") {
+		results, apiErr = s.FetchResultsFromExternalAPI(ctx, session.ID)
+	} else {
+		results, apiErr = s.FetchNonRaceSessionResults(ctx, session.ID)
+	}
+	if apiErr != nil {
+		return fail(apiErr.Message())
+	}
+	entry.Results = len(results)
+	entry.Status = dto.BackfillStatusIngested
+	return entry
+}
+
+// backfillMeeting devuelve el fin de semana de la sesión por su meeting_key y si no existe lo crea.
+// Las fechas y el formato se completan a medida que se le cargan las sesiones.
+func (s *resultService) backfillMeeting(providerSession racedata.Session, meetingsByKey map[int]int) (int, error) {
+	if meetingID, ok := meetingsByKey[providerSession.MeetingKey]; ok {
+		return meetingID, nil
+	}
+
+	meetingKey := providerSession.MeetingKey
+	created, err := s.sessionsClient.CreateMeeting(dto.CreateMeetingRequestDTO{
+		MeetingKey:       &meetingKey,
+		Name:             fmt.Sprintf("%s Grand Prix", providerSession.CountryName),
+		CircuitKey:       providerSession.CircuitKey,
+		CircuitShortName: providerSession.CircuitShortName,
+		CountryCode:      providerSession.CountryCode,
+		CountryKey:       providerSession.CountryKey,
+		CountryName:      providerSession.CountryName,
+		Location:         providerSession.Location,
+		DateStart:        providerSession.DateStart.UTC(),
+		DateEnd:          providerSession.DateEnd.UTC(),
+		Year:             providerSession.Year,
+	})
+	if err != nil {
+		return 0, err
+	}
+	meetingsByKey[providerSession.MeetingKey] = created.ID
+	return created.ID, nil
+}
+
+// backfillDriver busca al piloto del provider por siglas y nombre (el número puede haber cambiado o estar
+// reutilizado por otro piloto en la temporada actual) y si no existe lo crea. Los pilotos de temporadas
+// pasadas se crean inactivos para que la búsqueda por número de la temporada actual siga encontrando al actual.
+func (s *resultService) backfillDriver(driver racedata.Driver, year int) (dto.ResponseDriverDTO, bool, error) {
+	if driver.NameAcronym != "" {
+		candidates, err := s.driversClient.GetDriversByAcronym(driver.NameAcronym)
+		if err != nil {
+			return dto.ResponseDriverDTO{}, false, err
+		}
+		for _, candidate := range candidates {
+			if sameDriverName(candidate, driver) {
+				return candidate, false, nil
+			}
+		}
+		for _, candidate := range candidates {
+			if candidate.DriverNumber == driver.DriverNumber {
+				return candidate, false, nil
+			}
+		}
+	}
+
+	created, err := s.driversClient.CreateDriver(dto.CreateDriverRequestDTO{
+		BroadcastName: driver.BroadcastName,
+		CountryCode:   driver.CountryCode,
+		DriverNumber:  driver.DriverNumber,
+		FirstName:     driver.FirstName,
+		LastName:      driver.LastName,
+		FullName:      driver.FullName,
+		NameAcronym:   driver.NameAcronym,
+		HeadshotURL:   driver.HeadshotURL,
+		TeamName:      driver.TeamName,
+		Activo:        year >= time.Now().UTC().Year(),
+	})
+	if err != nil {
+		return dto.ResponseDriverDTO{}, false, err
+	}
+	return created, true, nil
+}
+
+// sameDriverName compara el nombre del piloto cargado con el del provider sin distinguir mayúsculas
+func sameDriverName(loaded dto.ResponseDriverDTO, driver racedata.Driver) bool {
+	if loaded.FullName != "" && driver.FullName != "" {
+		return strings.EqualFold(loaded.FullName, driver.FullName)
+	}
+	return strings.EqualFold(loaded.FirstName, driver.FirstName) && strings.EqualFold(loaded.LastName, driver.LastName)
+}
+
+// backfillSessionName identifica una sesión dentro de la temporada cuando no tiene session_key
+func backfillSessionName(location, sessionName, sessionType string) string {
+	return strings.ToLower(location + "|" + sessionName + "|" + sessionType)
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"prediapp.local/db/model"
+	"prediapp.local/racedata"
+	"prediapp.local/results/internal/dto"
+)
+
+// lappedGapRegex reconoce los gaps que la API informa como texto para pilotos doblados ("+1 LAP", "+2 LAPS")
+var lappedGapRegex = regexp.MustCompile(`(?i)^\+?\s*(\d+)\s*LAPS?$`)
+
+// driverLapSummary resume las vueltas de un piloto en la carrera
+type driverLapSummary struct {
+	LapsCompleted int
+	FastestLap    float64    // 0 si no tiene vueltas con tiempo
+	FinishedAt    *time.Time // Momento en que terminó su última vuelta (nil si no se puede calcular)
+}
+
+// driverGap es el último gap informado por la API para un piloto
+type driverGap struct {
+	GapToLeader     *float64
+	IntervalToAhead *float64
+	LapsBehind      int
+}
+
+// gridPositionsByDriver se queda con la primera posición reportada de cada piloto, que corresponde a la grilla de largada
+func gridPositionsByDriver(positions []racedata.Position) map[int]*int {
+	sorted := make([]racedata.Position, len(positions))
+	copy(sorted, positions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date < sorted[j].Date
+	})
+
+	gridPositions := make(map[int]*int)
+	for _, pos := range sorted {
+		if _, ok := gridPositions[pos.DriverNumber]; !ok && pos.Position != nil {
+			gridPositions[pos.DriverNumber] = pos.Position
+		}
+	}
+	return gridPositions
+}
+
+// parseRaceDataTime interpreta una fecha de la API externa (formato ISO 8601 de OpenF1)
+func parseRaceDataTime(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// summarizeLaps calcula vueltas completadas, vuelta más rápida y momento de llegada de cada piloto.
+// También devuelve la largada de la carrera (inicio de la vuelta 1), nil si la API no la informa.
+func summarizeLaps(lapsByDriver map[int][]racedata.Lap) (map[int]driverLapSummary, *time.Time) {
+	var raceStart *time.Time
+	summaries := make(map[int]driverLapSummary, len(lapsByDriver))
+
+	for driverNumber, laps := range lapsByDriver {
+		var summary driverLapSummary
+		for _, lap := range laps {
+			if lap.LapNumber > summary.LapsCompleted {
+				summary.LapsCompleted = lap.LapNumber
+			}
+			if lap.LapDuration > 0 && (summary.FastestLap == 0 || lap.LapDuration < summary.FastestLap) {
+				summary.FastestLap = lap.LapDuration
+			}
+
+			start, ok := parseRaceDataTime(lap.DateStart)
+			if !ok {
+				continue
+			}
+			if lap.LapNumber == 1 && (raceStart == nil || start.Before(*raceStart)) {
+				raceStart = &start
+			}
+			if lap.LapDuration > 0 {
+				end := start.Add(time.Duration(lap.LapDuration * float64(time.Second)))
+				if summary.FinishedAt == nil || end.After(*summary.FinishedAt) {
+					summary.FinishedAt = &end
+				}
+			}
+		}
+		summaries[driverNumber] = summary
+	}
+	return summaries, raceStart
+}
+
+// parseGapValue interpreta un gap de la API: número en segundos, texto "+N LAP(S)" o null
+func parseGapValue(raw json.RawMessage) (*float64, int, bool) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, 0, false
+	}
+
+	var seconds float64
+	if err := json.Unmarshal(raw, &seconds); err == nil {
+		return &seconds, 0, true
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		if match := lappedGapRegex.FindStringSubmatch(text); match != nil {
+			laps, _ := strconv.Atoi(match[1])
+			return nil, laps, true
+		}
+		if value, err := strconv.ParseFloat(text, 64); err == nil {
+			return &value, 0, true
+		}
+	}
+	return nil, 0, false
+}
+
+// lastGapsByDriver se queda con el último gap al líder y al auto de adelante informado para cada piloto
+func lastGapsByDriver(intervals []racedata.Interval) map[int]driverGap {
+	sorted := make([]racedata.Interval, len(intervals))
+	copy(sorted, intervals)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date < sorted[j].Date
+	})
+
+	gaps := make(map[int]driverGap)
+	for _, interval := range sorted {
+		gap := gaps[interval.DriverNumber]
+		if seconds, lapsBehind, ok := parseGapValue(interval.GapToLeader); ok {
+			gap.GapToLeader, gap.LapsBehind = seconds, lapsBehind
+		}
+		if seconds, _, ok := parseGapValue(interval.Interval); ok {
+			gap.IntervalToAhead = seconds
+		}
+		gaps[interval.DriverNumber] = gap
+	}
+	return gaps
+}
+
+// classifyRaceResults completa status, vueltas, tiempo total y diferencias de cada resultado de la carrera.
+// results y driverNumbers van en paralelo. Un piloto que cruzó la meta después del ganador cuenta como
+// FINISHED aunque esté doblado ("+1 Lap"); solo es DNF si dejó de girar antes de que termine la carrera.
+// Los DNF y DNS quedan sin posición.
+func classifyRaceResults(results []*model.Result, driverNumbers []int, leaderNumber int,
+	summaries map[int]driverLapSummary, raceStart *time.Time, gaps map[int]driverGap, gridPositions map[int]*int) {
+
+	leader := summaries[leaderNumber]
+
+	for i, result := range results {
+		driverNumber := driverNumbers[i]
+		summary := summaries[driverNumber]
+
+		result.GridPosition = gridPositions[driverNumber]
+		result.LapsCompleted = summary.LapsCompleted
+		result.FastestLapTime = summary.FastestLap
+		result.TotalTime, result.GapToLeader, result.IntervalToAhead, result.LapsBehind = nil, nil, nil, 0
+
+		// 1. Determinar el status
+		crossedLine := summary.FinishedAt != nil && leader.FinishedAt != nil && !summary.FinishedAt.Before(*leader.FinishedAt)
+		switch {
+		case summary.LapsCompleted == 0:
+			result.Status = "DNS" // Did Not Start
+		case summary.LapsCompleted >= leader.LapsCompleted || crossedLine:
+			result.Status = "FINISHED"
+		default:
+			result.Status = "DNF" // Did Not Finish
+		}
+		if result.Status != "FINISHED" {
+			// Los que no terminaron no tienen posición en la clasificación
+			result.Position = nil
+			continue
+		}
+
+		// 2. Tiempo total y vueltas de diferencia con el ganador
+		if raceStart != nil && summary.FinishedAt != nil {
+			total := summary.FinishedAt.Sub(*raceStart).Seconds()
+			result.TotalTime = &total
+		}
+		if summary.LapsCompleted < leader.LapsCompleted {
+			result.LapsBehind = leader.LapsCompleted - summary.LapsCompleted
+		}
+		if driverNumber == leaderNumber {
+			continue
+		}
+
+		// 3. Diferencias informadas por la API; si no hay, se calculan con los tiempos de llegada
+		if gap, ok := gaps[driverNumber]; ok {
+			if gap.LapsBehind > result.LapsBehind {
+				result.LapsBehind = gap.LapsBehind
+			}
+			if result.LapsBehind == 0 {
+				result.GapToLeader = gap.GapToLeader
+			}
+			result.IntervalToAhead = gap.IntervalToAhead
+		} else if result.LapsBehind == 0 && summary.FinishedAt != nil && leader.FinishedAt != nil {
+			gap := summary.FinishedAt.Sub(*leader.FinishedAt).Seconds()
+			result.GapToLeader = &gap
+		}
+	}
+
+	fillMissingIntervals(results, driverNumbers, summaries)
+}
+
+// fillMissingIntervals calcula el intervalo al auto de adelante cuando la API no lo informó,
+// solo entre pilotos que terminaron en la misma vuelta
+func fillMissingIntervals(results []*model.Result, driverNumbers []int, summaries map[int]driverLapSummary) {
+	order := make([]int, 0, len(results))
+	for i, result := range results {
+		if result.Status == "FINISHED" && result.Position != nil {
+			order = append(order, i)
+		}
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return *results[order[a]].Position < *results[order[b]].Position
+	})
+
+	for k := 1; k < len(order); k++ {
+		current, ahead := results[order[k]], results[order[k-1]]
+		if current.IntervalToAhead != nil || current.LapsBehind != ahead.LapsBehind {
+			continue
+		}
+		currentEnd := summaries[driverNumbers[order[k]]].FinishedAt
+		aheadEnd := summaries[driverNumbers[order[k-1]]].FinishedAt
+		if currentEnd != nil && aheadEnd != nil {
+			interval := currentEnd.Sub(*aheadEnd).Seconds()
+			current.IntervalToAhead = &interval
+		}
+	}
+}
+
+// classificationFromResult arma el bloque de clasificación detallada del DTO de respuesta
+func classificationFromResult(result *model.Result) dto.ClassificationDTO {
+	classification := dto.ClassificationDTO{
+		GridPosition:    result.GridPosition,
+		LapsCompleted:   result.LapsCompleted,
+		TotalTime:       result.TotalTime,
+		GapToLeader:     result.GapToLeader,
+		IntervalToAhead: result.IntervalToAhead,
+		LapsBehind:      result.LapsBehind,
+		Gap:             formatGap(result),
+		Points:          result.Points,
+		Q1Time:          result.Q1Time,
+		Q2Time:          result.Q2Time,
+		Q3Time:          result.Q3Time,
+		KnockoutSegment: result.KnockoutSegment,
+	}
+	if result.GridPosition != nil && result.Position != nil {
+		gained := *result.GridPosition - *result.Position
+		classification.PositionsGained = &gained
+	}
+	return classification
+}
+
+// formatGap devuelve la diferencia con el ganador para mostrar: "+1 Lap", "+2 Laps" o "+12.345s"
+func formatGap(result *model.Result) string {
+	switch {
+	case result.Status != "FINISHED":
+		return ""
+	case result.LapsBehind == 1:
+		return "+1 Lap"
+	case result.LapsBehind > 1:
+		return fmt.Sprintf("+%d Laps", result.LapsBehind)
+	case result.GapToLeader != nil:
+		return fmt.Sprintf("+%.3fs", *result.GapToLeader)
+	}
+	return ""
+}
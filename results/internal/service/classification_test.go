@@ -0,0 +1,151 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"prediapp.local/db/model"
+)
+
+func TestParseGapValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		wantSeconds *float64
+		wantLaps    int
+		wantOK      bool
+	}{
+		{"segundos", `3.417`, floatPtr(3.417), 0, true},
+		{"segundos como texto", `"+1.250"`, floatPtr(1.25), 0, true},
+		{"una vuelta", `"+1 LAP"`, nil, 1, true},
+		{"varias vueltas", `"+3 LAPS"`, nil, 3, true},
+		{"null", `null`, nil, 0, false},
+		{"vacío", ``, nil, 0, false},
+		{"texto desconocido", `"DNF"`, nil, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seconds, laps, ok := parseGapValue(json.RawMessage(tt.raw))
+			if ok != tt.wantOK || laps != tt.wantLaps || !equalFloatPtr(seconds, tt.wantSeconds) {
+				t.Errorf("parseGapValue(%s) = (%v, %d, %v), want (%v, %d, %v)",
+					tt.raw, fmtFloatPtr(seconds), laps, ok, fmtFloatPtr(tt.wantSeconds), tt.wantLaps, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestClassifyRaceResults(t *testing.T) {
+	raceStart := time.Date(2024, 3, 2, 15, 0, 0, 0, time.UTC)
+	at := func(seconds float64) *time.Time {
+		finished := raceStart.Add(time.Duration(seconds * float64(time.Second)))
+		return &finished
+	}
+	intPtr := func(v int) *int { return &v }
+
+	// Carrera de 50 vueltas que gana el auto 1
+	summaries := map[int]driverLapSummary{
+		1:  {LapsCompleted: 50, FastestLap: 92.1, FinishedAt: at(5000)},
+		2:  {LapsCompleted: 50, FastestLap: 92.4, FinishedAt: at(5003.2)},
+		3:  {LapsCompleted: 50, FastestLap: 92.9, FinishedAt: at(5006.5)},
+		4:  {LapsCompleted: 49, FastestLap: 93.5, FinishedAt: at(5040)}, // Doblado: cruza la meta después del ganador
+		5:  {LapsCompleted: 48, FastestLap: 93.8, FinishedAt: at(5060)}, // Doblado dos veces según la API
+		6:  {LapsCompleted: 31, FastestLap: 93.0, FinishedAt: at(3100)}, // Abandona
+		7:  {LapsCompleted: 0},                                          // No larga
+		8:  {LapsCompleted: 50, FastestLap: 94.0},                       // Sin tiempos de llegada
+		10: {LapsCompleted: 49, FastestLap: 93.3, FinishedAt: at(4950)}, // Deja de girar antes del final
+	}
+	gaps := map[int]driverGap{
+		2: {GapToLeader: floatPtr(3.2), IntervalToAhead: floatPtr(3.2)},
+		5: {LapsBehind: 2},
+	}
+	grid := map[int]*int{1: intPtr(2), 2: intPtr(1), 3: intPtr(5)}
+
+	tests := []struct {
+		driverNumber int
+		position     int
+		wantStatus   string
+		wantPosition bool
+		wantBehind   int
+		wantTotal    *float64
+		wantGap      *float64
+		wantInterval *float64
+	}{
+		{1, 1, "FINISHED", true, 0, floatPtr(5000), nil, nil},
+		{2, 2, "FINISHED", true, 0, floatPtr(5003.2), floatPtr(3.2), floatPtr(3.2)},
+		{3, 3, "FINISHED", true, 0, floatPtr(5006.5), floatPtr(6.5), floatPtr(3.3)},
+		{4, 4, "FINISHED", true, 1, floatPtr(5040), nil, nil},
+		{5, 5, "FINISHED", true, 2, floatPtr(5060), nil, nil},
+		{8, 6, "FINISHED", true, 0, nil, nil, nil},
+		{10, 7, "DNF", false, 0, nil, nil, nil},
+		{6, 8, "DNF", false, 0, nil, nil, nil},
+		{7, 9, "DNS", false, 0, nil, nil, nil},
+	}
+
+	results := make([]*model.Result, 0, len(tests))
+	driverNumbers := make([]int, 0, len(tests))
+	for _, tt := range tests {
+		results = append(results, &model.Result{DriverID: tt.driverNumber * 100, Position: intPtr(tt.position)})
+		driverNumbers = append(driverNumbers, tt.driverNumber)
+	}
+	classifyRaceResults(results, driverNumbers, 1, summaries, &raceStart, gaps, grid)
+
+	for i, tt := range tests {
+		result := results[i]
+		t.Run(fmt.Sprintf("auto %d", tt.driverNumber), func(t *testing.T) {
+			if result.Status != tt.wantStatus {
+				t.Errorf("auto %d: status = %q, want %q", tt.driverNumber, result.Status, tt.wantStatus)
+			}
+			if (result.Position != nil) != tt.wantPosition {
+				t.Errorf("auto %d: position = %v, want posición %v", tt.driverNumber, result.Position, tt.wantPosition)
+			}
+			if result.LapsCompleted != summaries[tt.driverNumber].LapsCompleted {
+				t.Errorf("auto %d: laps_completed = %d, want %d", tt.driverNumber, result.LapsCompleted, summaries[tt.driverNumber].LapsCompleted)
+			}
+			if result.LapsBehind != tt.wantBehind {
+				t.Errorf("auto %d: laps_behind = %d, want %d", tt.driverNumber, result.LapsBehind, tt.wantBehind)
+			}
+			if !equalFloatPtr(result.TotalTime, tt.wantTotal) {
+				t.Errorf("auto %d: total_time = %v, want %v", tt.driverNumber, fmtFloatPtr(result.TotalTime), fmtFloatPtr(tt.wantTotal))
+			}
+			if !equalFloatPtr(result.GapToLeader, tt.wantGap) {
+				t.Errorf("auto %d: gap_to_leader = %v, want %v", tt.driverNumber, fmtFloatPtr(result.GapToLeader), fmtFloatPtr(tt.wantGap))
+			}
+			if !equalFloatPtr(result.IntervalToAhead, tt.wantInterval) {
+				t.Errorf("auto %d: interval = %v, want %v", tt.driverNumber, fmtFloatPtr(result.IntervalToAhead), fmtFloatPtr(tt.wantInterval))
+			}
+			if !equalIntPtr(result.GridPosition, grid[tt.driverNumber]) {
+				t.Errorf("auto %d: grid_position = %v, want %v", tt.driverNumber, result.GridPosition, grid[tt.driverNumber])
+			}
+		})
+	}
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+// equalFloatPtr compara con tolerancia de milésimas, la precisión de los tiempos de OpenF1
+func equalFloatPtr(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	diff := *a - *b
+	return diff < 0.001 && diff > -0.001
+}
+
+func equalIntPtr(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func fmtFloatPtr(v *float64) interface{} {
+	if v == nil {
+		return "nil"
+	}
+	return *v
+}
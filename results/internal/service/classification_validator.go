@@ -0,0 +1,197 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"prediapp.local/db/model"
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// classifiedEntry es un resultado de la clasificación a validar
+type classifiedEntry struct {
+	DriverID int
+	Position *int
+	Status   string
+}
+
+// classificationViolation es una violación con los índices de las entradas involucradas
+type classificationViolation struct {
+	dto.ClassificationViolationDTO
+	Entries []int
+}
+
+// classificationEntries arma las entradas a validar a partir de resultados
+func classificationEntries(results []*model.Result) []classifiedEntry {
+	entries := make([]classifiedEntry, 0, len(results))
+	for _, result := range results {
+		entries = append(entries, classifiedEntry{DriverID: result.DriverID, Position: result.Position, Status: result.Status})
+	}
+	return entries
+}
+
+// validateClassification revisa la clasificación completa de una sesión y devuelve todas las violaciones juntas:
+// pilotos repetidos, posiciones repetidas, huecos en el orden de llegada, más clasificados que participantes
+// y status que no coinciden con la posición (FINISHED sin posición o DNF, DNS, DSQ con posición).
+// entrants es la cantidad de autos en la sesión (0 si no se conoce).
+func validateClassification(entries []classifiedEntry, entrants int) []classificationViolation {
+	var violations []classificationViolation
+
+	// 1. Agrupar por piloto y por posición
+	byDriver := make(map[int][]int)
+	byPosition := make(map[int][]int)
+	finishers := 0
+	for i, entry := range entries {
+		if entry.DriverID != 0 {
+			byDriver[entry.DriverID] = append(byDriver[entry.DriverID], i)
+		}
+		if entry.Position != nil {
+			byPosition[*entry.Position] = append(byPosition[*entry.Position], i)
+		}
+		if entry.Status == "FINISHED" {
+			finishers++
+		}
+	}
+
+	// 2. Pilotos repetidos
+	for _, driverID := range sortedKeys(byDriver) {
+		if indexes := byDriver[driverID]; len(indexes) > 1 {
+			violations = append(violations, classificationViolation{
+				ClassificationViolationDTO: dto.ClassificationViolationDTO{
+					Code:      dto.ViolationDuplicateDriver,
+					Message:   fmt.Sprintf("El piloto %d aparece %d veces", driverID, len(indexes)),
+					DriverIDs: []int{driverID},
+				},
+				Entries: indexes,
+			})
+		}
+	}
+
+	// 3. Posiciones repetidas (entre pilotos distintos) y huecos hasta la última posición asignada
+	positions := sortedKeys(byPosition)
+	for _, position := range positions {
+		indexes := byPosition[position]
+		driverIDs := distinctDrivers(entries, indexes)
+		if len(driverIDs) > 1 {
+			position := position
+			violations = append(violations, classificationViolation{
+				ClassificationViolationDTO: dto.ClassificationViolationDTO{
+					Code:      dto.ViolationDuplicatePosition,
+					Message:   fmt.Sprintf("La posición %d está asignada a %d pilotos", position, len(driverIDs)),
+					DriverIDs: driverIDs,
+					Position:  &position,
+				},
+				Entries: indexes,
+			})
+		}
+	}
+	if len(positions) > 0 {
+		for position := 1; position < positions[len(positions)-1]; position++ {
+			if _, ok := byPosition[position]; !ok {
+				position := position
+				violations = append(violations, classificationViolation{
+					ClassificationViolationDTO: dto.ClassificationViolationDTO{
+						Code:     dto.ViolationPositionGap,
+						Message:  fmt.Sprintf("Falta la posición %d en el orden de llegada", position),
+						Position: &position,
+					},
+				})
+			}
+		}
+	}
+
+	// 4. Status y posición tienen que coincidir. Las entradas sin status ya se reportan por otro lado.
+	for i, entry := range entries {
+		var message string
+		switch {
+		case entry.Status == "":
+			continue
+		case entry.Status == "FINISHED" && entry.Position == nil:
+			message = fmt.Sprintf("El piloto %d terminó (FINISHED) sin posición", entry.DriverID)
+		case entry.Status != "FINISHED" && entry.Position != nil:
+			message = fmt.Sprintf("El piloto %d tiene la posición %d con status %s", entry.DriverID, *entry.Position, entry.Status)
+		default:
+			continue
+		}
+		violations = append(violations, classificationViolation{
+			ClassificationViolationDTO: dto.ClassificationViolationDTO{
+				Code:      dto.ViolationStatusPosition,
+				Message:   message,
+				DriverIDs: []int{entry.DriverID},
+				Position:  entry.Position,
+			},
+			Entries: []int{i},
+		})
+	}
+
+	// 5. Más clasificados que autos en la sesión
+	if entrants > 0 && finishers > entrants {
+		violations = append(violations, classificationViolation{
+			ClassificationViolationDTO: dto.ClassificationViolationDTO{
+				Code:    dto.ViolationTooManyFinishers,
+				Message: fmt.Sprintf("Hay %d pilotos clasificados y la sesión tiene %d participantes", finishers, entrants),
+			},
+		})
+	}
+
+	return violations
+}
+
+// providerEntrants cuenta los autos que el provider informa para la sesión (0 si no se pudieron obtener)
+func (s *resultService) providerEntrants(sessionKey int) int {
+	drivers, err := s.raceData.GetDrivers(sessionKey)
+	if err != nil {
+		fmt.Printf("Error obteniendo los pilotos de la sesión %d, no se controla la cantidad de participantes: %v\n", sessionKey, err)
+		return 0
+	}
+	numbers := make(map[int]bool, len(drivers))
+	for _, driver := range drivers {
+		numbers[driver.DriverNumber] = true
+	}
+	return len(numbers)
+}
+
+// sessionEntrants devuelve los autos de una sesión cargada a mano según el provider.
+// Sin session key (sesiones creadas sin datos de OpenF1) devuelve 0 y no se controla la cantidad de participantes.
+func (s *resultService) sessionEntrants(sessionID int) int {
+	sessionKey, err := s.sessionsClient.GetSessionKeyBySessionID(sessionID)
+	if err != nil || sessionKey == 0 {
+		return 0
+	}
+	return s.providerEntrants(sessionKey)
+}
+
+// classificationError arma el error de validación con todas las violaciones como causa
+func classificationError(violations []classificationViolation) e.ApiError {
+	cause := make(e.CauseList, 0, len(violations))
+	for _, violation := range violations {
+		cause = append(cause, violation.ClassificationViolationDTO)
+	}
+	return e.NewValidationApiError(
+		fmt.Sprintf("La clasificación tiene %d problemas de consistencia", len(violations)),
+		"invalid_classification",
+		cause,
+	)
+}
+
+func distinctDrivers(entries []classifiedEntry, indexes []int) []int {
+	seen := make(map[int]bool)
+	var driverIDs []int
+	for _, i := range indexes {
+		if driverID := entries[i].DriverID; !seen[driverID] {
+			seen[driverID] = true
+			driverIDs = append(driverIDs, driverID)
+		}
+	}
+	return driverIDs
+}
+
+func sortedKeys(groups map[int][]int) []int {
+	keys := make([]int, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	return keys
+}
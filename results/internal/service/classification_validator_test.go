@@ -0,0 +1,125 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"prediapp.local/results/internal/dto"
+)
+
+func TestValidateClassification(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	finished := func(driverID, position int) classifiedEntry {
+		return classifiedEntry{DriverID: driverID, Position: intPtr(position), Status: "FINISHED"}
+	}
+	out := func(driverID int, status string) classifiedEntry {
+		return classifiedEntry{DriverID: driverID, Status: status}
+	}
+
+	tests := []struct {
+		name      string
+		entries   []classifiedEntry
+		entrants  int
+		wantCodes []string
+	}{
+		{
+			name:      "clasificación consistente",
+			entries:   []classifiedEntry{finished(1, 1), finished(2, 2), out(3, "DNF"), out(4, "DSQ")},
+			entrants:  4,
+			wantCodes: nil,
+		},
+		{
+			name:      "piloto repetido",
+			entries:   []classifiedEntry{finished(1, 1), finished(2, 2), out(1, "DNF")},
+			wantCodes: []string{dto.ViolationDuplicateDriver},
+		},
+		{
+			name:      "posición repetida",
+			entries:   []classifiedEntry{finished(1, 1), finished(2, 1), finished(3, 2)},
+			wantCodes: []string{dto.ViolationDuplicatePosition},
+		},
+		{
+			name:      "hueco en el orden de llegada",
+			entries:   []classifiedEntry{finished(1, 1), finished(2, 3)},
+			wantCodes: []string{dto.ViolationPositionGap},
+		},
+		{
+			name:      "más clasificados que participantes",
+			entries:   []classifiedEntry{finished(1, 1), finished(2, 2), finished(3, 3)},
+			entrants:  2,
+			wantCodes: []string{dto.ViolationTooManyFinishers},
+		},
+		{
+			name:      "participantes desconocidos no se controlan",
+			entries:   []classifiedEntry{finished(1, 1), finished(2, 2), finished(3, 3)},
+			entrants:  0,
+			wantCodes: nil,
+		},
+		{
+			name:      "FINISHED sin posición",
+			entries:   []classifiedEntry{finished(1, 1), out(2, "FINISHED")},
+			wantCodes: []string{dto.ViolationStatusPosition},
+		},
+		{
+			name:      "DNF con posición",
+			entries:   []classifiedEntry{finished(1, 1), {DriverID: 2, Position: intPtr(2), Status: "DNF"}},
+			wantCodes: []string{dto.ViolationStatusPosition},
+		},
+		{
+			name:      "sin status no se compara con la posición",
+			entries:   []classifiedEntry{finished(1, 1), {DriverID: 2, Position: intPtr(2)}},
+			wantCodes: nil,
+		},
+		{
+			name: "reporta todas las violaciones juntas",
+			entries: []classifiedEntry{
+				finished(1, 1), finished(1, 2), finished(2, 2), finished(3, 5),
+				{DriverID: 4, Position: intPtr(6), Status: "DSQ"},
+			},
+			entrants: 3,
+			wantCodes: []string{
+				dto.ViolationDuplicateDriver, dto.ViolationDuplicatePosition, dto.ViolationPositionGap,
+				dto.ViolationPositionGap, dto.ViolationStatusPosition, dto.ViolationTooManyFinishers,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var codes []string
+			for _, violation := range validateClassification(tt.entries, tt.entrants) {
+				codes = append(codes, violation.Code)
+			}
+			if !reflect.DeepEqual(codes, tt.wantCodes) {
+				t.Errorf("validateClassification() codes = %v, want %v", codes, tt.wantCodes)
+			}
+		})
+	}
+}
+
+func TestValidateClassificationEntries(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	entries := []classifiedEntry{
+		{DriverID: 1, Position: intPtr(1), Status: "FINISHED"},
+		{DriverID: 2, Position: intPtr(2), Status: "FINISHED"},
+		{DriverID: 3, Position: intPtr(2), Status: "FINISHED"},
+		{DriverID: 4, Position: intPtr(3), Status: "DNF"},
+	}
+
+	violations := validateClassification(entries, 0)
+	if len(violations) != 2 {
+		t.Fatalf("validateClassification() = %d violaciones, want 2", len(violations))
+	}
+
+	duplicate := violations[0]
+	if duplicate.Code != dto.ViolationDuplicatePosition || !reflect.DeepEqual(duplicate.Entries, []int{1, 2}) ||
+		!reflect.DeepEqual(duplicate.DriverIDs, []int{2, 3}) {
+		t.Errorf("posición repetida = %+v, want entradas [1 2] y pilotos [2 3]", duplicate)
+	}
+
+	mismatch := violations[1]
+	if mismatch.Code != dto.ViolationStatusPosition || !reflect.DeepEqual(mismatch.Entries, []int{3}) ||
+		!reflect.DeepEqual(mismatch.DriverIDs, []int{4}) {
+		t.Errorf("status y posición = %+v, want entrada [3] y piloto [4]", mismatch)
+	}
+}
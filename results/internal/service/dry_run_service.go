@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"prediapp.local/db/model"
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// sessionResultsPlan es lo que haría una carga masiva: qué resultados crear y actualizar, y el diff para mostrar
+type sessionResultsPlan struct {
+	toCreate []*model.Result
+	toUpdate []*model.Result
+	merged   []*model.Result // Resultados de la sesión tal como quedarían
+	changes  []dto.ResultChangeDTO
+}
+
+// planSessionResults valida la carga masiva y calcula los cambios sin guardar nada.
+// Lo usan tanto la carga real como el dry run, así ambos aplican exactamente las mismas reglas.
+func (s *resultService) planSessionResults(ctx context.Context, bulkRequest dto.CreateBulkResultsDTO) (*sessionResultsPlan, e.ApiError) {
+	if bulkRequest.SessionID == 0 {
+		return nil, e.NewBadRequestApiError("El session_id no puede ser 0")
+	}
+	if apiErr := s.checkResultsEditable(ctx, bulkRequest.SessionID); apiErr != nil {
+		return nil, apiErr
+	}
+
+	existingResults, err := s.resultRepo.GetResultsBySessionID(ctx, bulkRequest.SessionID)
+	if err != nil {
+		return nil, e.NewInternalServerApiError("Error obteniendo resultados existentes", err)
+	}
+
+	existingResultsMap := make(map[int]*model.Result)
+	for _, r := range existingResults {
+		existingResultsMap[r.DriverID] = r
+	}
+
+	plan := &sessionResultsPlan{merged: existingResults}
+	var submitted []classifiedEntry
+	for _, item := range bulkRequest.Results {
+		if apiErr := normalizeResultItem(&item); apiErr != nil {
+			return nil, apiErr
+		}
+		submitted = append(submitted, classifiedEntry{DriverID: item.DriverID, Position: item.Position, Status: item.Status})
+
+		change := dto.ResultChangeDTO{
+			DriverID: item.DriverID,
+			After:    dto.ResultSnapshotDTO{Position: item.Position, Status: item.Status, FastestLapTime: item.FastestLapTime},
+		}
+
+		if existingResult, exists := existingResultsMap[item.DriverID]; exists {
+			before := resultSnapshot(existingResult)
+			change.Before = &before
+			change.Fields = changedResultFields(before, change.After)
+			change.Action = dto.ResultActionUpdate
+			if len(change.Fields) == 0 {
+				change.Action = dto.ResultActionUnchanged
+			}
+
+			existingResult.Position = item.Position
+			existingResult.Status = item.Status
+			existingResult.FastestLapTime = item.FastestLapTime
+			plan.toUpdate = append(plan.toUpdate, existingResult)
+		} else {
+			newResult := &model.Result{
+				SessionID:      bulkRequest.SessionID,
+				DriverID:       item.DriverID,
+				Position:       item.Position,
+				Status:         item.Status,
+				FastestLapTime: item.FastestLapTime,
+			}
+			change.Action = dto.ResultActionCreate
+			plan.toCreate = append(plan.toCreate, newResult)
+			plan.merged = append(plan.merged, newResult)
+		}
+		plan.changes = append(plan.changes, change)
+	}
+
+	// La clasificación completa (lo cargado más lo que ya estaba y no se tocó) tiene que ser consistente
+	submittedDrivers := make(map[int]bool, len(submitted))
+	for _, entry := range submitted {
+		submittedDrivers[entry.DriverID] = true
+	}
+	entries := submitted
+	for _, r := range existingResults {
+		if !submittedDrivers[r.DriverID] {
+			entries = append(entries, classifiedEntry{DriverID: r.DriverID, Position: r.Position, Status: r.Status})
+		}
+	}
+	if violations := validateClassification(entries, s.sessionEntrants(bulkRequest.SessionID)); len(violations) > 0 {
+		return nil, classificationError(violations)
+	}
+
+	return plan, nil
+}
+
+// PreviewSessionResultsAdmin simula la carga masiva: valida igual que la carga real y devuelve el diff
+// contra lo guardado junto con el impacto en los puntajes de los prodes, sin escribir nada.
+func (s *resultService) PreviewSessionResultsAdmin(ctx context.Context, bulkRequest dto.CreateBulkResultsDTO) (dto.BulkResultsDiffDTO, e.ApiError) {
+	// 1. Planificar con las mismas validaciones que la carga real
+	plan, apiErr := s.planSessionResults(ctx, bulkRequest)
+	if apiErr != nil {
+		return dto.BulkResultsDiffDTO{}, apiErr
+	}
+
+	diff := dto.BulkResultsDiffDTO{
+		SessionID: bulkRequest.SessionID,
+		DryRun:    true,
+		Changes:   plan.changes,
+	}
+	for _, change := range plan.changes {
+		switch change.Action {
+		case dto.ResultActionCreate:
+			diff.ToCreate++
+		case dto.ResultActionUpdate:
+			diff.ToUpdate++
+		default:
+			diff.Unchanged++
+		}
+	}
+
+	// 2. Simular los puntajes de los prodes con la clasificación resultante
+	sessionData, err := s.sessionsClient.GetSessionByID(bulkRequest.SessionID)
+	if err != nil {
+		diff.Prodes.Error = err.Error()
+		return diff, nil
+	}
+	isRace := strings.EqualFold(sessionData.SessionName, "race") || strings.EqualFold(sessionData.SessionType, "race")
+	n := 3
+	if isRace {
+		n = 5
+	}
+
+	impact, err := s.prodesClient.PreviewScores(bulkRequest.SessionID, isRace, topDriversFromResults(plan.merged, n))
+	if err != nil {
+		diff.Prodes.Error = err.Error()
+		return diff, nil
+	}
+	diff.Prodes = impact
+	return diff, nil
+}
+
+// topDriversFromResults arma el top N con los resultados que tienen posición, igual que GetTopNDriversInSession
+func topDriversFromResults(results []*model.Result, n int) []dto.TopDriverDTO {
+	var classified []*model.Result
+	for _, r := range results {
+		if r.Position != nil {
+			classified = append(classified, r)
+		}
+	}
+	sort.SliceStable(classified, func(i, j int) bool {
+		return *classified[i].Position < *classified[j].Position
+	})
+	if n > len(classified) {
+		n = len(classified)
+	}
+
+	topDrivers := make([]dto.TopDriverDTO, 0, n)
+	for _, r := range classified[:n] {
+		topDrivers = append(topDrivers, dto.TopDriverDTO{Position: *r.Position, DriverID: r.DriverID})
+	}
+	return topDrivers
+}
+
+func resultSnapshot(result *model.Result) dto.ResultSnapshotDTO {
+	return dto.ResultSnapshotDTO{Position: result.Position, Status: result.Status, FastestLapTime: result.FastestLapTime}
+}
+
+// changedResultFields lista los campos que difieren entre el resultado guardado y el propuesto
+func changedResultFields(before, after dto.ResultSnapshotDTO) []string {
+	var fields []string
+	if !equalPositions(before.Position, after.Position) {
+		fields = append(fields, "position")
+	}
+	if before.Status != after.Status {
+		fields = append(fields, "status")
+	}
+	if before.FastestLapTime != after.FastestLapTime {
+		fields = append(fields, "fastest_lap_time")
+	}
+	return fields
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"prediapp.local/db/model"
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// GetHeadToHead compara a un piloto con sus compañeros de equipo en las sesiones de la temporada que corrieron juntos:
+// quién clasificó y terminó adelante, puntos, abandonos y diferencia promedio. Se calcula con los resultados guardados.
+func (s *resultService) GetHeadToHead(ctx context.Context, driverID, year int) (dto.HeadToHeadDTO, e.ApiError) {
+	driver, teammates, apiErr := s.resultRepo.GetDriverWithTeammates(ctx, driverID)
+	if apiErr != nil {
+		return dto.HeadToHeadDTO{}, apiErr
+	}
+	response := dto.HeadToHeadDTO{
+		Year:      year,
+		Driver:    toDriverDTO(driver.ID, driver),
+		Teammates: make([]dto.TeammateHeadToHeadDTO, 0, len(teammates)),
+	}
+	if len(teammates) == 0 {
+		return response, nil
+	}
+
+	// 1. Resultados de todos en la temporada, agrupados por sesión y piloto
+	driverIDs := []int{driver.ID}
+	for _, teammate := range teammates {
+		driverIDs = append(driverIDs, teammate.ID)
+	}
+	results, apiErr := s.resultRepo.GetSeasonResultsForDrivers(ctx, year, driverIDs)
+	if apiErr != nil {
+		return dto.HeadToHeadDTO{}, apiErr
+	}
+	var sessions []*model.Session
+	bySession := make(map[int]map[int]*model.Result)
+	for _, result := range results {
+		if result.Session == nil {
+			continue
+		}
+		if _, ok := bySession[result.SessionID]; !ok {
+			bySession[result.SessionID] = make(map[int]*model.Result)
+			sessions = append(sessions, result.Session)
+		}
+		bySession[result.SessionID][result.DriverID] = result
+	}
+
+	// 2. Un mano a mano por compañero, solo con las sesiones en las que estuvieron los dos
+	for _, teammate := range teammates {
+		entry := dto.TeammateHeadToHeadDTO{Teammate: toDriverDTO(teammate.ID, teammate)}
+		var qualifyingGaps, finishGaps []float64
+		for _, session := range sessions {
+			mine, theirs := bySession[session.ID][driver.ID], bySession[session.ID][teammate.ID]
+			if mine == nil || theirs == nil {
+				continue
+			}
+			switch {
+			case strings.EqualFold(session.SessionName, "qualifying"):
+				if !countAhead(&entry.Qualifying, mine.Position, theirs.Position) {
+					continue
+				}
+				entry.QualifyingSessions++
+				if gap, ok := qualifyingGap(mine, theirs); ok {
+					qualifyingGaps = append(qualifyingGaps, gap)
+				}
+			case pointsSessionKind(session) != "":
+				entry.RaceSessions++
+				entry.Points.Driver += mine.Points
+				entry.Points.Teammate += theirs.Points
+				if mine.Status == "DNF" {
+					entry.DNFs.Driver++
+				}
+				if theirs.Status == "DNF" {
+					entry.DNFs.Teammate++
+				}
+				// Si ninguno terminó, la carrera no cuenta para el mano a mano
+				countAhead(&entry.Race, finishingPosition(mine), finishingPosition(theirs))
+				if scoresPoints(mine) && scoresPoints(theirs) {
+					finishGaps = append(finishGaps, float64(*mine.Position-*theirs.Position))
+				}
+			}
+		}
+		entry.AverageQualifyingGap = averageGap(qualifyingGaps, 1000)
+		entry.AverageFinishGap = averageGap(finishGaps, 100)
+		response.Teammates = append(response.Teammates, entry)
+	}
+	return response, nil
+}
+
+// countAhead suma el mano a mano al que quedó adelante. Sin posición se pierde contra quien la tenga;
+// si ninguno tiene posición no se cuenta y devuelve false.
+func countAhead(count *dto.HeadToHeadCountDTO, mine, theirs *int) bool {
+	switch {
+	case mine == nil && theirs == nil:
+		return false
+	case theirs == nil || (mine != nil && *mine < *theirs):
+		count.Driver++
+	default:
+		count.Teammate++
+	}
+	return true
+}
+
+// finishingPosition devuelve la posición solo si el piloto terminó la carrera
+func finishingPosition(result *model.Result) *int {
+	if !scoresPoints(result) {
+		return nil
+	}
+	return result.Position
+}
+
+// qualifyingGap compara la mejor vuelta de la última tanda que disputaron los dos; si no hay tandas usa la vuelta rápida
+func qualifyingGap(mine, theirs *model.Result) (float64, bool) {
+	myTimes := [3]*float64{mine.Q1Time, mine.Q2Time, mine.Q3Time}
+	theirTimes := [3]*float64{theirs.Q1Time, theirs.Q2Time, theirs.Q3Time}
+	for segment := 2; segment >= 0; segment-- {
+		if myTimes[segment] != nil && theirTimes[segment] != nil {
+			return *myTimes[segment] - *theirTimes[segment], true
+		}
+	}
+	if mine.FastestLapTime > 0 && theirs.FastestLapTime > 0 {
+		return mine.FastestLapTime - theirs.FastestLapTime, true
+	}
+	return 0, false
+}
+
+// averageGap promedia las diferencias redondeando a 1/scale (nil si no hay ninguna)
+func averageGap(gaps []float64, scale float64) *float64 {
+	if len(gaps) == 0 {
+		return nil
+	}
+	total := 0.0
+	for _, gap := range gaps {
+		total += gap
+	}
+	average := math.Round(total/float64(len(gaps))*scale) / scale
+	return &average
+}
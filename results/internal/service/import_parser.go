@@ -0,0 +1,237 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Formatos de archivo aceptados por la importación de resultados
+const (
+	importFormatCSV  = "csv"
+	importFormatJSON = "json"
+)
+
+// importColumnAliases mapea los encabezados aceptados a su nombre canónico.
+// Incluye los de la exportación de clasificación de la FIA (POS, NO, TIME/RETIRED).
+var importColumnAliases = map[string]string{
+	"pos":              "position",
+	"position":         "position",
+	"posicion":         "position",
+	"no":               "number",
+	"nro":              "number",
+	"number":           "number",
+	"driver_number":    "number",
+	"car":              "number",
+	"#":                "number",
+	"acronym":          "acronym",
+	"name_acronym":     "acronym",
+	"code":             "acronym",
+	"driver_code":      "acronym",
+	"abbreviation":     "acronym",
+	"driver_id":        "driver_id",
+	"status":           "status",
+	"time":             "time",
+	"time/retired":     "time",
+	"time_retired":     "time",
+	"fastest_lap":      "fastest_lap",
+	"fastest_lap_time": "fastest_lap",
+	"best_lap":         "fastest_lap",
+	"best_lap_time":    "fastest_lap",
+}
+
+// importStatusAliases traduce los valores de status/posición de la FIA a los status del sistema
+var importStatusAliases = map[string]string{
+	"FINISHED": "FINISHED",
+	"DNF":      "DNF",
+	"RET":      "DNF",
+	"NC":       "DNF",
+	"DNS":      "DNS",
+	"DSQ":      "DSQ",
+	"DQ":       "DSQ",
+	"EX":       "DSQ",
+}
+
+// importRow es una fila del archivo con las columnas ya normalizadas
+type importRow struct {
+	Line   int
+	Fields map[string]string
+}
+
+// parseImportFile detecta el formato (por extensión o contenido) y devuelve las filas del archivo
+func parseImportFile(filename string, content []byte) (string, []importRow, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")) // BOM de Excel
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) == 0 {
+		return "", nil, fmt.Errorf("el archivo está vacío")
+	}
+
+	if strings.EqualFold(filepath.Ext(filename), ".json") || trimmed[0] == '[' || trimmed[0] == '{' {
+		rows, err := parseImportJSON(trimmed)
+		return importFormatJSON, rows, err
+	}
+	rows, err := parseImportCSV(content)
+	return importFormatCSV, rows, err
+}
+
+// parseImportCSV lee un CSV con encabezado. Acepta "," o ";" como separador.
+func parseImportCSV(content []byte) ([]importRow, error) {
+	firstLine := content
+	if i := bytes.IndexByte(content, '\n'); i >= 0 {
+		firstLine = content[:i]
+	}
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo leer el encabezado: %w", err)
+	}
+	columns, err := canonicalColumns(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("CSV inválido: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		row := importRow{Line: line, Fields: make(map[string]string)}
+		empty := true
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			if i < len(columns) && columns[i] != "" && value != "" {
+				row.Fields[columns[i]] = value
+				empty = false
+			}
+		}
+		if !empty {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// parseImportJSON lee un array de objetos (o {"results": [...]}) con las mismas columnas que el CSV
+func parseImportJSON(content []byte) ([]importRow, error) {
+	var records []map[string]interface{}
+	if content[0] == '{' {
+		var wrapper struct {
+			Results []map[string]interface{} `json:"results"`
+		}
+		if err := json.Unmarshal(content, &wrapper); err != nil {
+			return nil, fmt.Errorf("JSON inválido: %w", err)
+		}
+		records = wrapper.Results
+	} else if err := json.Unmarshal(content, &records); err != nil {
+		return nil, fmt.Errorf("JSON inválido: %w", err)
+	}
+
+	rows := make([]importRow, 0, len(records))
+	for i, record := range records {
+		row := importRow{Line: i + 1, Fields: make(map[string]string)}
+		for key, value := range record {
+			column := importColumnAliases[normalizeImportHeader(key)]
+			if column == "" || value == nil {
+				continue
+			}
+			var text string
+			switch v := value.(type) {
+			case float64:
+				text = strconv.FormatFloat(v, 'f', -1, 64)
+			case string:
+				text = strings.TrimSpace(v)
+			default:
+				text = fmt.Sprint(v)
+			}
+			if text != "" {
+				row.Fields[column] = text
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// canonicalColumns traduce el encabezado; las columnas desconocidas (DRIVER, TEAM, PTS...) se ignoran
+func canonicalColumns(header []string) ([]string, error) {
+	columns := make([]string, len(header))
+	found := make(map[string]bool)
+	for i, name := range header {
+		columns[i] = importColumnAliases[normalizeImportHeader(name)]
+		found[columns[i]] = true
+	}
+	if !found["number"] && !found["acronym"] && !found["driver_id"] {
+		return nil, fmt.Errorf("el encabezado debe incluir una columna de piloto: número (NO), acrónimo o driver_id")
+	}
+	return columns, nil
+}
+
+func normalizeImportHeader(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.TrimSuffix(name, ".")
+	return strings.ReplaceAll(name, " ", "_")
+}
+
+// parseImportPosition interpreta la columna de posición: un número o un status de la FIA ("NC", "DQ", "DNS")
+func parseImportPosition(value string) (*int, string, error) {
+	if value == "" {
+		return nil, "", nil
+	}
+	if status, ok := importStatusAliases[strings.ToUpper(value)]; ok {
+		return nil, status, nil
+	}
+	position, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, "", fmt.Errorf("posición inválida: %q", value)
+	}
+	return &position, "", nil
+}
+
+// parseImportStatus interpreta la columna de status ("" si viene vacía)
+func parseImportStatus(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if status, ok := importStatusAliases[strings.ToUpper(value)]; ok {
+		return status, nil
+	}
+	return "", fmt.Errorf("status inválido: %q", value)
+}
+
+// parseImportLapTime acepta segundos ("92.608") o minutos:segundos ("1:32.608")
+func parseImportLapTime(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	minutes := 0
+	if i := strings.Index(value, ":"); i >= 0 {
+		m, err := strconv.Atoi(value[:i])
+		if err != nil {
+			return 0, fmt.Errorf("vuelta rápida inválida: %q", value)
+		}
+		minutes, value = m, value[i+1:]
+	}
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("vuelta rápida inválida: %q", value)
+	}
+	return float64(minutes)*60 + seconds, nil
+}
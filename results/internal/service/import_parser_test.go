@@ -0,0 +1,227 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"prediapp.local/results/internal/dto"
+)
+
+func TestParseImportFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		filename   string
+		content    string
+		wantFormat string
+		wantRows   []importRow
+		wantErr    bool
+	}{
+		{
+			name:       "CSV con coma",
+			filename:   "results.csv",
+			content:    "position,number,fastest_lap\n1,1,1:32.608\n2,16,\n",
+			wantFormat: importFormatCSV,
+			wantRows: []importRow{
+				{Line: 2, Fields: map[string]string{"position": "1", "number": "1", "fastest_lap": "1:32.608"}},
+				{Line: 3, Fields: map[string]string{"position": "2", "number": "16"}},
+			},
+		},
+		{
+			name:       "exportación de la FIA con punto y coma, BOM y columnas ignoradas",
+			filename:   "clasificacion.txt",
+			content:    "\xef\xbb\xbfPOS.;NO.;DRIVER;TEAM;TIME/RETIRED\n1;1;Max Verstappen;Red Bull;1:31:44.742\n\nNC;2;Logan Sargeant;Williams;DNF\n",
+			wantFormat: importFormatCSV,
+			wantRows: []importRow{
+				{Line: 2, Fields: map[string]string{"position": "1", "number": "1", "time": "1:31:44.742"}},
+				{Line: 4, Fields: map[string]string{"position": "NC", "number": "2", "time": "DNF"}},
+			},
+		},
+		{
+			name:       "JSON como array",
+			filename:   "results",
+			content:    `[{"Driver_Number": 44, "Status": "DNF", "team": "Mercedes"}, {"acronym": " VER ", "position": 1, "fastest_lap": null}]`,
+			wantFormat: importFormatJSON,
+			wantRows: []importRow{
+				{Line: 1, Fields: map[string]string{"number": "44", "status": "DNF"}},
+				{Line: 2, Fields: map[string]string{"acronym": "VER", "position": "1"}},
+			},
+		},
+		{
+			name:       "JSON con results",
+			filename:   "results.json",
+			content:    `{"results": [{"driver_id": 7, "pos": "3"}]}`,
+			wantFormat: importFormatJSON,
+			wantRows:   []importRow{{Line: 1, Fields: map[string]string{"driver_id": "7", "position": "3"}}},
+		},
+		{
+			name:     "archivo vacío",
+			filename: "results.csv",
+			content:  " \n ",
+			wantErr:  true,
+		},
+		{
+			name:       "CSV sin columna de piloto",
+			filename:   "results.csv",
+			content:    "position,team\n1,Ferrari\n",
+			wantFormat: importFormatCSV,
+			wantErr:    true,
+		},
+		{
+			name:       "JSON inválido",
+			filename:   "results.json",
+			content:    `[{"number": 1}`,
+			wantFormat: importFormatJSON,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, rows, err := parseImportFile(tt.filename, []byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseImportFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if format != tt.wantFormat {
+				t.Errorf("parseImportFile() format = %q, want %q", format, tt.wantFormat)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(rows, tt.wantRows) {
+				t.Errorf("parseImportFile() rows = %+v, want %+v", rows, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestParseImportPosition(t *testing.T) {
+	tests := []struct {
+		value        string
+		wantPosition *int
+		wantStatus   string
+		wantErr      bool
+	}{
+		{"", nil, "", false},
+		{"7", intValue(7), "", false},
+		{"NC", nil, "DNF", false},
+		{"dq", nil, "DSQ", false},
+		{"DNS", nil, "DNS", false},
+		{"P1", nil, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			position, status, err := parseImportPosition(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseImportPosition(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !equalIntPtr(position, tt.wantPosition) || status != tt.wantStatus {
+				t.Errorf("parseImportPosition(%q) = (%v, %q), want (%v, %q)", tt.value, position, status, tt.wantPosition, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestParseImportStatus(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"", "", false},
+		{"finished", "FINISHED", false},
+		{"RET", "DNF", false},
+		{"EX", "DSQ", false},
+		{"+1 LAP", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseImportStatus(tt.value)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("parseImportStatus(%q) = (%q, %v), want (%q, wantErr %v)", tt.value, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseImportLapTime(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    float64
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"92.608", 92.608, false},
+		{"1:32.608", 92.608, false},
+		{"2:00", 120, false},
+		{"1:xx", 0, true},
+		{"a:32.1", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseImportLapTime(tt.value)
+			if (err != nil) != tt.wantErr || !equalFloatPtr(&got, &tt.want) {
+				t.Errorf("parseImportLapTime(%q) = (%v, %v), want (%v, wantErr %v)", tt.value, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMarkClassificationViolations(t *testing.T) {
+	row := func(line, driverID int, position *int, status string) dto.ImportRowReportDTO {
+		return dto.ImportRowReportDTO{Row: line, DriverID: driverID, Position: position, Status: status, Valid: true}
+	}
+
+	tests := []struct {
+		name           string
+		rows           []dto.ImportRowReportDTO
+		entrants       int
+		wantInvalid    []int // Filas del archivo que quedan inválidas
+		wantFileErrors int
+	}{
+		{
+			name:     "archivo consistente",
+			rows:     []dto.ImportRowReportDTO{row(2, 1, intValue(1), "FINISHED"), row(3, 2, nil, "DNF")},
+			entrants: 2,
+		},
+		{
+			name:        "la fila que repite piloto o posición queda inválida",
+			rows:        []dto.ImportRowReportDTO{row(2, 1, intValue(1), "FINISHED"), row(3, 1, nil, "DNF"), row(4, 3, intValue(1), "FINISHED")},
+			wantInvalid: []int{3, 4},
+		},
+		{
+			name:        "status que no coincide con la posición invalida su fila",
+			rows:        []dto.ImportRowReportDTO{row(2, 1, intValue(1), "FINISHED"), row(3, 2, intValue(2), "DSQ")},
+			wantInvalid: []int{3},
+		},
+		{
+			name:           "huecos y exceso de clasificados son errores del archivo",
+			rows:           []dto.ImportRowReportDTO{row(2, 1, intValue(1), "FINISHED"), row(3, 2, intValue(3), "FINISHED")},
+			entrants:       1,
+			wantFileErrors: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := &dto.ImportReportDTO{Rows: tt.rows}
+			markClassificationViolations(report, tt.entrants)
+
+			var invalid []int
+			for _, r := range report.Rows {
+				if !r.Valid {
+					invalid = append(invalid, r.Row)
+				}
+			}
+			if !reflect.DeepEqual(invalid, tt.wantInvalid) {
+				t.Errorf("filas inválidas = %v, want %v", invalid, tt.wantInvalid)
+			}
+			if len(report.FileErrors) != tt.wantFileErrors {
+				t.Errorf("errores del archivo = %v, want %d", report.FileErrors, tt.wantFileErrors)
+			}
+		})
+	}
+}
+
+func intValue(v int) *int {
+	return &v
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// ImportSessionResults valida un archivo de clasificación (CSV o JSON) fila por fila y devuelve el reporte.
+// Solo si commit es true y todas las filas son válidas guarda los resultados, igual que POST /results/admin.
+func (s *resultService) ImportSessionResults(ctx context.Context, sessionID int, filename string, content []byte, commit bool) (dto.ImportReportDTO, e.ApiError) {
+	if sessionID == 0 {
+		return dto.ImportReportDTO{}, e.NewBadRequestApiError("El session_id no puede ser 0")
+	}
+	if apiErr := s.checkResultsEditable(ctx, sessionID); apiErr != nil {
+		return dto.ImportReportDTO{}, apiErr
+	}
+
+	// 1. Leer el archivo
+	format, rows, err := parseImportFile(filename, content)
+	if err != nil {
+		return dto.ImportReportDTO{}, e.NewBadRequestApiError(fmt.Sprintf("No se pudo leer el archivo: %v", err))
+	}
+
+	report := dto.ImportReportDTO{
+		SessionID: sessionID,
+		Format:    format,
+		TotalRows: len(rows),
+		Rows:      make([]dto.ImportRowReportDTO, 0, len(rows)),
+	}
+	if len(rows) == 0 {
+		report.FileErrors = append(report.FileErrors, "El archivo no tiene filas de resultados")
+		return report, nil
+	}
+
+	// 2. Validar cada fila y resolver el piloto
+	resolver := newImportDriverResolver(s)
+	items := make([]dto.CreateResultItemDTO, len(rows))
+	for i, row := range rows {
+		report.Rows = append(report.Rows, s.validateImportRow(row, resolver, &items[i]))
+	}
+
+	// 3. Validaciones entre filas: la clasificación completa del archivo tiene que ser consistente
+	markClassificationViolations(&report, s.sessionEntrants(sessionID))
+
+	var bulk dto.CreateBulkResultsDTO
+	bulk.SessionID = sessionID
+	for i, row := range report.Rows {
+		if row.Valid {
+			report.ValidRows++
+			bulk.Results = append(bulk.Results, items[i])
+		}
+	}
+	report.Valid = report.ValidRows == report.TotalRows && len(report.FileErrors) == 0
+
+	// 4. Guardar solo si se pidió y no hay errores
+	if !commit || !report.Valid {
+		return report, nil
+	}
+	results, apiErr := s.CreateSessionResultsAdmin(ctx, bulk)
+	if apiErr != nil {
+		return dto.ImportReportDTO{}, apiErr
+	}
+	report.Committed = true
+	report.Results = results
+	return report, nil
+}
+
+// validateImportRow arma el ítem de carga masiva de una fila y reporta sus errores
+func (s *resultService) validateImportRow(row importRow, resolver *importDriverResolver, item *dto.CreateResultItemDTO) dto.ImportRowReportDTO {
+	report := dto.ImportRowReportDTO{Row: row.Line}
+	addError := func(err error) {
+		if err != nil {
+			report.Errors = append(report.Errors, err.Error())
+		}
+	}
+
+	// Piloto
+	driver, ref, err := resolver.resolve(row.Fields)
+	report.DriverRef = ref
+	addError(err)
+	if err == nil {
+		item.DriverID = driver.ID
+		report.DriverID = driver.ID
+		report.DriverName = driver.FullName
+	}
+
+	// Posición y status. La columna de posición puede traer el status de la FIA ("NC", "DQ");
+	// la de status tiene prioridad, y en el formato FIA también se mira TIME/RETIRED.
+	position, positionStatus, err := parseImportPosition(row.Fields["position"])
+	addError(err)
+	status, err := parseImportStatus(row.Fields["status"])
+	addError(err)
+	if status == "" {
+		status = positionStatus
+	}
+	if status == "" {
+		status, _ = parseImportStatus(row.Fields["time"])
+		if status != "" && status != "FINISHED" {
+			position = nil
+		}
+	}
+	item.Position = position
+	item.Status = status
+
+	// Vuelta rápida
+	fastestLap, err := parseImportLapTime(row.Fields["fastest_lap"])
+	addError(err)
+	item.FastestLapTime = fastestLap
+
+	// Mismas reglas que la carga masiva
+	if len(report.Errors) == 0 {
+		if apiErr := normalizeResultItem(item); apiErr != nil {
+			report.Errors = append(report.Errors, apiErr.Message())
+		}
+	}
+
+	report.Position = item.Position
+	report.Status = item.Status
+	report.FastestLapTime = item.FastestLapTime
+	report.Valid = len(report.Errors) == 0
+	return report
+}
+
+// markClassificationViolations valida la clasificación del archivo con las mismas reglas que la carga masiva.
+// Los pilotos y posiciones repetidos invalidan la fila que repite, un status que no coincide con la posición
+// invalida su fila y los huecos y el exceso de clasificados son errores del archivo. entrants es la cantidad de autos de la sesión (0 si no se conoce).
+func markClassificationViolations(report *dto.ImportReportDTO, entrants int) {
+	entries := make([]classifiedEntry, 0, len(report.Rows))
+	for _, row := range report.Rows {
+		entries = append(entries, classifiedEntry{DriverID: row.DriverID, Position: row.Position, Status: row.Status})
+	}
+
+	for _, violation := range validateClassification(entries, entrants) {
+		report.Violations = append(report.Violations, violation.ClassificationViolationDTO)
+		if len(violation.Entries) == 0 {
+			report.FileErrors = append(report.FileErrors, violation.Message)
+			continue
+		}
+		if violation.Code == dto.ViolationStatusPosition {
+			row := &report.Rows[violation.Entries[0]]
+			row.Errors = append(row.Errors, violation.Message)
+			continue
+		}
+		first := report.Rows[violation.Entries[0]].Row
+		for _, i := range violation.Entries[1:] {
+			row := &report.Rows[i]
+			if violation.Code == dto.ViolationDuplicateDriver {
+				row.Errors = append(row.Errors, fmt.Sprintf("Piloto repetido (ya aparece en la fila %d)", first))
+			} else {
+				row.Errors = append(row.Errors, fmt.Sprintf("Posición %d repetida (ya aparece en la fila %d)", *row.Position, first))
+			}
+		}
+	}
+
+	for i := range report.Rows {
+		report.Rows[i].Valid = len(report.Rows[i].Errors) == 0
+	}
+}
+
+// importDriverResolver resuelve pilotos por driver_id, número o acrónimo, recordando las búsquedas del archivo
+type importDriverResolver struct {
+	service  *resultService
+	acronyms map[string]dto.ResponseDriverDTO
+}
+
+func newImportDriverResolver(s *resultService) *importDriverResolver {
+	return &importDriverResolver{service: s, acronyms: make(map[string]dto.ResponseDriverDTO)}
+}
+
+// resolve devuelve el piloto de la fila y la referencia usada para buscarlo
+func (r *importDriverResolver) resolve(fields map[string]string) (dto.ResponseDriverDTO, string, error) {
+	if value := fields["driver_id"]; value != "" {
+		driverID, err := strconv.Atoi(value)
+		if err != nil || driverID <= 0 {
+			return dto.ResponseDriverDTO{}, value, fmt.Errorf("driver_id inválido: %q", value)
+		}
+		return dto.ResponseDriverDTO{ID: driverID}, value, nil
+	}
+
+	if value := fields["number"]; value != "" {
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return dto.ResponseDriverDTO{}, value, fmt.Errorf("número de piloto inválido: %q", value)
+		}
+		driver, err := r.service.getDriverByNumber(number)
+		if err != nil {
+			return dto.ResponseDriverDTO{}, value, fmt.Errorf("no se encontró el piloto con número %d", number)
+		}
+		return driver, value, nil
+	}
+
+	if value := fields["acronym"]; value != "" {
+		acronym := strings.ToUpper(value)
+		if driver, ok := r.acronyms[acronym]; ok {
+			return driver, value, nil
+		}
+		drivers, err := r.service.driversClient.GetDriversByAcronym(acronym)
+		if err != nil {
+			return dto.ResponseDriverDTO{}, value, fmt.Errorf("error buscando el piloto %s: %v", acronym, err)
+		}
+		switch len(drivers) {
+		case 0:
+			return dto.ResponseDriverDTO{}, value, fmt.Errorf("no se encontró el piloto con acrónimo %s", acronym)
+		case 1:
+			r.acronyms[acronym] = drivers[0]
+			return drivers[0], value, nil
+		default:
+			return dto.ResponseDriverDTO{}, value, fmt.Errorf("el acrónimo %s corresponde a %d pilotos, use el número", acronym, len(drivers))
+		}
+	}
+
+	return dto.ResponseDriverDTO{}, "", fmt.Errorf("la fila no identifica al piloto (número, acrónimo o driver_id)")
+}
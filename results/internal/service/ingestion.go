@@ -0,0 +1,148 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"prediapp.local/db/model"
+	"prediapp.local/racedata"
+	"prediapp.local/results/internal/dto"
+)
+
+const (
+	// defaultIngestionWorkers limita las consultas concurrentes al microservicio de drivers durante una ingesta
+	defaultIngestionWorkers = 5
+	// driverCacheTTL es cuánto se recuerda la info de un piloto por driver_number
+	driverCacheTTL = 30 * time.Minute
+)
+
+// finalPositionsByDriver se queda con la última posición reportada de cada piloto (driverNumber -> *int)
+func finalPositionsByDriver(positions []racedata.Position) map[int]*int {
+	positionsByDriver := make(map[int][]racedata.Position)
+	for _, pos := range positions {
+		positionsByDriver[pos.DriverNumber] = append(positionsByDriver[pos.DriverNumber], pos)
+	}
+
+	finalPositions := make(map[int]*int, len(positionsByDriver))
+	for driverNumber, driverPositions := range positionsByDriver {
+		sort.Slice(driverPositions, func(i, j int) bool {
+			return driverPositions[i].Date < driverPositions[j].Date
+		})
+		finalPositions[driverNumber] = driverPositions[len(driverPositions)-1].Position
+	}
+	return finalPositions
+}
+
+// sortedDriverNumbers devuelve los driver_number del mapa ordenados, para procesar siempre en el mismo orden
+func sortedDriverNumbers(finalPositions map[int]*int) []int {
+	driverNumbers := make([]int, 0, len(finalPositions))
+	for driverNumber := range finalPositions {
+		driverNumbers = append(driverNumbers, driverNumber)
+	}
+	sort.Ints(driverNumbers)
+	return driverNumbers
+}
+
+// getLapsByDriver trae las vueltas de todos los pilotos en una sola llamada y las agrupa por driver_number.
+// Incluye las vueltas sin tiempo (vuelta 1, vueltas interrumpidas) porque cuentan como vueltas completadas.
+func (s *resultService) getLapsByDriver(sessionKey int) (map[int][]racedata.Lap, error) {
+	laps, err := s.raceData.GetLaps(sessionKey, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	lapsByDriver := make(map[int][]racedata.Lap)
+	for _, lap := range laps {
+		lapsByDriver[lap.DriverNumber] = append(lapsByDriver[lap.DriverNumber], lap)
+	}
+	return lapsByDriver, nil
+}
+
+// getDriverByNumber obtiene la info del piloto desde el microservicio de drivers, usando la caché si está
+func (s *resultService) getDriverByNumber(driverNumber int) (dto.ResponseDriverDTO, error) {
+	key := strconv.Itoa(driverNumber)
+	if cached, ok := s.driverCache.Get(key); ok {
+		return cached.(dto.ResponseDriverDTO), nil
+	}
+
+	driver, err := s.driversClient.GetDriverByNumber(driverNumber)
+	if err != nil {
+		return dto.ResponseDriverDTO{}, err
+	}
+	s.driverCache.Set(key, driver, driverCacheTTL)
+	return driver, nil
+}
+
+// getDriversByNumber resuelve varios pilotos en paralelo con un pool acotado de workers.
+// Los pilotos que no se pueden obtener se loguean y quedan fuera del mapa.
+func (s *resultService) getDriversByNumber(driverNumbers []int) map[int]dto.ResponseDriverDTO {
+	jobs := make(chan int)
+	drivers := make(map[int]dto.ResponseDriverDTO, len(driverNumbers))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	workers := s.ingestionWorkers
+	if workers < 1 {
+		workers = 1
+	}
+	if len(driverNumbers) < workers {
+		workers = len(driverNumbers)
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for driverNumber := range jobs {
+				driver, err := s.getDriverByNumber(driverNumber)
+				if err != nil {
+					fmt.Printf("Error obteniendo info del driver_number %d: %v\n", driverNumber, err)
+					continue
+				}
+				mu.Lock()
+				drivers[driverNumber] = driver
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, driverNumber := range driverNumbers {
+		jobs <- driverNumber
+	}
+	close(jobs)
+	wg.Wait()
+
+	return drivers
+}
+
+// buildIngestedResultDTO arma el DTO de respuesta de un resultado recién ingerido
+func buildIngestedResultDTO(result *model.Result, driverInfo dto.ResponseDriverDTO, sessionData dto.ResponseSessionDTO) dto.ResponseResultDTO {
+	return dto.ResponseResultDTO{
+		ID:             result.ID,
+		Position:       result.Position,
+		Status:         result.Status,
+		FastestLapTime: result.FastestLapTime,
+		Driver: dto.ResponseDriverDTO{
+			ID:          driverInfo.ID,
+			FirstName:   driverInfo.FirstName,
+			LastName:    driverInfo.LastName,
+			FullName:    driverInfo.FullName,
+			NameAcronym: driverInfo.NameAcronym,
+			TeamName:    driverInfo.TeamName,
+		},
+		Session: dto.ResponseSessionDTO{
+			ID:               sessionData.ID,
+			CircuitShortName: sessionData.CircuitShortName,
+			CountryName:      sessionData.CountryName,
+			Location:         sessionData.Location,
+			SessionName:      sessionData.SessionName,
+			SessionType:      sessionData.SessionType,
+			DateStart:        sessionData.DateStart,
+		},
+		CreatedAt:         result.CreatedAt,
+		UpdatedAt:         result.UpdatedAt,
+		ClassificationDTO: classificationFromResult(result),
+	}
+}
@@ -0,0 +1,302 @@
+package service
+
+// Benchmark de FetchResultsFromExternalAPI sin red ni base de datos: los datos de OpenF1 salen del
+// provider de fixtures (generados para la carrera), los microservicios de drivers y sessions son un
+// servidor HTTP local y el repositorio es un mapa en memoria. Cada llamada al provider, al servidor
+// y al repositorio suma una latencia fija para simular la red.
+//
+// Compara la consulta de pilotos en serie contra el pool de workers:
+//
+//	go test ./internal/service -run '^$' -bench BenchmarkFetchResultsFromExternalAPI -benchtime 5x
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"prediapp.local/db/model"
+	"prediapp.local/racedata"
+	"prediapp.local/results/internal/client"
+	"prediapp.local/results/internal/repository"
+	e "prediapp.local/results/pkg/utils"
+)
+
+const (
+	benchSessionID  = 1
+	benchSessionKey = 9999
+	benchDrivers    = 20
+	benchLaps       = 57
+	benchLatency    = 40 * time.Millisecond // Por llamada HTTP (OpenF1 y microservicios)
+	benchDBLatency  = 3 * time.Millisecond  // Por operación de DB
+)
+
+func BenchmarkFetchResultsFromExternalAPI(b *testing.B) {
+	if os.Getenv("JWT_SECRET") == "" {
+		os.Setenv("JWT_SECRET", "bench")
+	}
+
+	dir := b.TempDir()
+	if err := writeBenchFixtures(dir, benchDrivers, benchLaps); err != nil {
+		b.Fatalf("fixtures: %v", err)
+	}
+	fixtures, err := racedata.NewFixtureProvider(dir)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	server := httptest.NewServer(benchServicesHandler(benchLatency))
+	defer server.Close()
+
+	for _, bench := range []struct {
+		name    string
+		workers int
+	}{
+		{"serial", 1},
+		{"pooled", defaultIngestionWorkers},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			provider := &slowProvider{RaceDataProvider: fixtures, latency: benchLatency}
+			repo := newMemoryRepository(benchDBLatency)
+			svc := NewResultService(repo,
+				client.NewHttpClient(server.URL),
+				client.NewHttpClient(server.URL),
+				client.NewHttpClient(server.URL),
+				client.NewHttpClient(server.URL),
+				provider,
+			).(*resultService)
+			svc.ingestionWorkers = bench.workers
+
+			// El servicio loguea con fmt.Print; se silencia para que no ensucie la medición
+			stdout := os.Stdout
+			devNull, _ := os.Open(os.DevNull)
+			defer func() {
+				os.Stdout = stdout
+				devNull.Close()
+			}()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// Cada ingesta arranca con la caché de pilotos vacía, como la primera de una carrera
+				b.StopTimer()
+				svc.driverCache = e.NewCache(driverCacheTTL, 100)
+				os.Stdout = devNull
+				b.StartTimer()
+
+				results, apiErr := svc.FetchResultsFromExternalAPI(context.Background(), benchSessionID)
+
+				b.StopTimer()
+				os.Stdout = stdout
+				if apiErr != nil {
+					b.Fatalf("ingesta: %v", apiErr)
+				}
+				if len(results) != benchDrivers {
+					b.Fatalf("se esperaban %d resultados, hubo %d", benchDrivers, len(results))
+				}
+				b.StartTimer()
+			}
+			b.ReportMetric(float64(provider.calls)/float64(b.N), "openf1-calls/op")
+			b.ReportMetric(float64(repo.calls)/float64(b.N), "db-ops/op")
+		})
+	}
+}
+
+// writeBenchFixtures genera posiciones, vueltas y pilotos de una carrera donde el piloto i termina en la posición i
+func writeBenchFixtures(dir string, drivers, laps int) error {
+	var positions []racedata.Position
+	var allLaps []racedata.Lap
+	var allDrivers []racedata.Driver
+	for n := 1; n <= drivers; n++ {
+		pos := n
+		positions = append(positions, racedata.Position{SessionKey: benchSessionKey, DriverNumber: n, Position: &pos, Date: "2024-03-02T17:00:00"})
+		for lap := 1; lap <= laps; lap++ {
+			allLaps = append(allLaps, racedata.Lap{SessionKey: benchSessionKey, DriverNumber: n, LapNumber: lap, LapDuration: 90 + float64(n)/10 + float64(lap%7)/100})
+		}
+		allDrivers = append(allDrivers, racedata.Driver{SessionKey: benchSessionKey, DriverNumber: n, NameAcronym: "D" + strconv.Itoa(n)})
+	}
+
+	for name, data := range map[string]interface{}{
+		racedata.EndpointPosition: positions,
+		racedata.EndpointLaps:     allLaps,
+		racedata.EndpointDrivers:  allDrivers,
+	} {
+		body, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, name+".json"), body, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// slowProvider agrega latencia a todas las llamadas del provider y las cuenta
+type slowProvider struct {
+	racedata.RaceDataProvider
+	latency time.Duration
+	mu      sync.Mutex
+	calls   int
+}
+
+func (p *slowProvider) wait() {
+	p.mu.Lock()
+	p.calls++
+	p.mu.Unlock()
+	time.Sleep(p.latency)
+}
+
+func (p *slowProvider) GetSessions(filter racedata.SessionFilter) ([]racedata.Session, error) {
+	p.wait()
+	return p.RaceDataProvider.GetSessions(filter)
+}
+
+func (p *slowProvider) GetPositions(sessionKey int) ([]racedata.Position, error) {
+	p.wait()
+	return p.RaceDataProvider.GetPositions(sessionKey)
+}
+
+func (p *slowProvider) GetLaps(sessionKey int, driverNumber int) ([]racedata.Lap, error) {
+	p.wait()
+	return p.RaceDataProvider.GetLaps(sessionKey, driverNumber)
+}
+
+func (p *slowProvider) GetDrivers(sessionKey int) ([]racedata.Driver, error) {
+	p.wait()
+	return p.RaceDataProvider.GetDrivers(sessionKey)
+}
+
+func (p *slowProvider) GetRaceControl(sessionKey int) ([]racedata.RaceControlEvent, error) {
+	p.wait()
+	return p.RaceDataProvider.GetRaceControl(sessionKey)
+}
+
+func (p *slowProvider) GetPitStops(sessionKey int) ([]racedata.PitStop, error) {
+	p.wait()
+	return p.RaceDataProvider.GetPitStops(sessionKey)
+}
+
+func (p *slowProvider) GetIntervals(sessionKey int, driverNumber int) ([]racedata.Interval, error) {
+	p.wait()
+	return p.RaceDataProvider.GetIntervals(sessionKey, driverNumber)
+}
+
+func (p *slowProvider) GetWeather(sessionKey int) ([]racedata.Weather, error) {
+	p.wait()
+	return p.RaceDataProvider.GetWeather(sessionKey)
+}
+
+// benchServicesHandler imita los endpoints de drivers y sessions que usa la ingesta
+func benchServicesHandler(latency time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(latency)
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case len(parts) == 3 && parts[0] == "drivers" && parts[1] == "number":
+			number, _ := strconv.Atoi(parts[2])
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id": number, "driver_number": number,
+				"first_name": "Driver", "last_name": parts[2], "full_name": "Driver " + parts[2],
+				"name_acronym": "D" + parts[2], "team_name": "Team",
+			})
+		case len(parts) == 3 && parts[0] == "sessions" && parts[2] == "get-session-key":
+			json.NewEncoder(w).Encode(map[string]int{"session_key": benchSessionKey})
+		case len(parts) == 2 && parts[0] == "sessions":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id": benchSessionID, "session_name": "Race", "session_type": "Race",
+				"location": "Sakhir", "country_name": "Bahrain", "circuit_short_name": "Sakhir",
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// memoryRepository implementa las operaciones de ResultRepository que usa la ingesta.
+// Cualquier otro método entra en pánico a través de la interfaz embebida nil.
+type memoryRepository struct {
+	repository.ResultRepository
+	latency time.Duration
+	mu      sync.Mutex
+	calls   int
+	nextID  int
+	results map[[2]int]*model.Result
+}
+
+func newMemoryRepository(latency time.Duration) *memoryRepository {
+	return &memoryRepository{latency: latency, results: make(map[[2]int]*model.Result)}
+}
+
+func (r *memoryRepository) op() {
+	r.calls++
+	time.Sleep(r.latency)
+}
+
+func (r *memoryRepository) UpsertSessionResults(ctx context.Context, sessionID int, results []*model.Result) e.ApiError {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.op()
+	now := time.Now()
+	for _, result := range results {
+		key := [2]int{sessionID, result.DriverID}
+		if current, ok := r.results[key]; ok {
+			result.ID, result.CreatedAt = current.ID, current.CreatedAt
+		} else {
+			r.nextID++
+			result.ID, result.CreatedAt = r.nextID, now
+		}
+		result.UpdatedAt = now
+		copied := *result
+		r.results[key] = &copied
+	}
+	return nil
+}
+
+func (r *memoryRepository) GetSessionResultStatus(ctx context.Context, sessionID int) (*model.SessionResultStatus, e.ApiError) {
+	return nil, e.NewNotFoundApiError("Result status not found for session")
+}
+
+func (r *memoryRepository) EnsureProvisionalStatus(ctx context.Context, sessionID int) e.ApiError {
+	return nil
+}
+
+func (r *memoryRepository) ReplaceSessionLaps(ctx context.Context, sessionID int, laps []*model.Lap) e.ApiError {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.op()
+	return nil
+}
+
+func (r *memoryRepository) ReplaceSessionPitStops(ctx context.Context, sessionID int, pitStops []*model.PitStop) e.ApiError {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.op()
+	return nil
+}
+
+func (r *memoryRepository) GetResultsBySessionID(ctx context.Context, sessionID int) ([]*model.Result, e.ApiError) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.op()
+	var results []*model.Result
+	for key, result := range r.results {
+		if key[0] == sessionID {
+			copied := *result
+			results = append(results, &copied)
+		}
+	}
+	return results, nil
+}
+
+func (r *memoryRepository) UpdateSessionPoints(ctx context.Context, sessionID int, pointsByResult map[int]float64) e.ApiError {
+	return nil
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"prediapp.local/db/model"
+	"prediapp.local/results/internal/dto"
+	"prediapp.local/results/internal/repository"
+	e "prediapp.local/results/pkg/utils"
+)
+
+const (
+	// ingestionMaxAttempts es la cantidad de intentos antes de marcar la ingesta como fallida
+	ingestionMaxAttempts = 6
+	// ingestionBaseBackoff es la espera antes del primer reintento; se duplica en cada intento
+	ingestionBaseBackoff = 5 * time.Minute
+	// ingestionMaxBackoff acota la espera entre reintentos
+	ingestionMaxBackoff = 2 * time.Hour
+	// ingestionLookback evita reintentar sesiones viejas que nunca tuvieron resultados
+	ingestionLookback = 7 * 24 * time.Hour
+	// ingestionRunningTimeout es cuánto puede quedar una ingesta en running antes de considerarla cortada
+	ingestionRunningTimeout = 15 * time.Minute
+)
+
+type ingestionService struct {
+	ingestionRepo repository.IngestionRepository
+	resultService ResultService
+}
+
+type IngestionServiceInterface interface {
+	RunPendingIngestions(ctx context.Context) e.ApiError
+	IngestSession(ctx context.Context, sessionID int) (dto.SessionIngestionDTO, e.ApiError)
+	GetIngestionStatus(ctx context.Context, sessionID int) (dto.SessionIngestionDTO, e.ApiError)
+	ListIngestions(ctx context.Context, status string) ([]dto.SessionIngestionDTO, e.ApiError)
+	StartIngestionJob(ctx context.Context, interval time.Duration)
+}
+
+// NewIngestionService crea el servicio de ingesta automática de resultados
+func NewIngestionService(ingestionRepo repository.IngestionRepository, resultService ResultService) IngestionServiceInterface {
+	return &ingestionService{
+		ingestionRepo: ingestionRepo,
+		resultService: resultService,
+	}
+}
+
+// RunPendingIngestions intenta cargar los resultados de todas las sesiones terminadas que no los tienen
+func (s *ingestionService) RunPendingIngestions(ctx context.Context) e.ApiError {
+	now := time.Now().UTC()
+	sessions, apiErr := s.ingestionRepo.GetSessionsPendingIngestion(ctx, now, now.Add(-ingestionLookback), now.Add(-ingestionRunningTimeout))
+	if apiErr != nil {
+		return apiErr
+	}
+
+	for _, session := range sessions {
+		if ctx.Err() != nil {
+			return nil
+		}
+		ingestion, apiErr := s.ingest(ctx, session)
+		if apiErr != nil {
+			log.Printf("Ingestion job: error guardando el estado de la sesión %d: %v", session.ID, apiErr)
+			continue
+		}
+		if ingestion.Status != model.IngestionStatusSucceeded {
+			log.Printf("Ingestion job: sesión %d en estado %s (intento %d): %s", session.ID, ingestion.Status, ingestion.Attempts, ingestion.LastError)
+		}
+	}
+	return nil
+}
+
+// IngestSession fuerza la ingesta de una sesión (por ejemplo para reintentar una fallida).
+// Reinicia el contador de intentos.
+func (s *ingestionService) IngestSession(ctx context.Context, sessionID int) (dto.SessionIngestionDTO, e.ApiError) {
+	session, apiErr := s.ingestionRepo.GetSessionByID(ctx, sessionID)
+	if apiErr != nil {
+		return dto.SessionIngestionDTO{}, apiErr
+	}
+	if session.DateEnd.After(time.Now().UTC()) {
+		return dto.SessionIngestionDTO{}, e.NewBadRequestApiError("La sesión todavía no terminó")
+	}
+
+	ingestion, apiErr := s.ingestionRepo.GetIngestionBySessionID(ctx, sessionID)
+	if apiErr == nil {
+		ingestion.Attempts = 0
+		if apiErr := s.ingestionRepo.SaveIngestion(ctx, ingestion); apiErr != nil {
+			return dto.SessionIngestionDTO{}, apiErr
+		}
+	}
+
+	ingestion, apiErr = s.ingest(ctx, session)
+	if apiErr != nil {
+		return dto.SessionIngestionDTO{}, apiErr
+	}
+	return toSessionIngestionDTO(ingestion, session), nil
+}
+
+// GetIngestionStatus devuelve el estado de ingesta de una sesión
+func (s *ingestionService) GetIngestionStatus(ctx context.Context, sessionID int) (dto.SessionIngestionDTO, e.ApiError) {
+	ingestion, apiErr := s.ingestionRepo.GetIngestionBySessionID(ctx, sessionID)
+	if apiErr != nil {
+		return dto.SessionIngestionDTO{}, apiErr
+	}
+	return toSessionIngestionDTO(ingestion, ingestion.Session), nil
+}
+
+// ListIngestions lista los estados de ingesta, opcionalmente filtrados por status
+func (s *ingestionService) ListIngestions(ctx context.Context, status string) ([]dto.SessionIngestionDTO, e.ApiError) {
+	switch status {
+	case "", model.IngestionStatusPending, model.IngestionStatusRunning, model.IngestionStatusSucceeded, model.IngestionStatusFailed:
+	default:
+		return nil, e.NewBadRequestApiError(fmt.Sprintf("Status inválido: %s", status))
+	}
+
+	ingestions, apiErr := s.ingestionRepo.ListIngestions(ctx, status)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	response := make([]dto.SessionIngestionDTO, 0, len(ingestions))
+	for _, ingestion := range ingestions {
+		response = append(response, toSessionIngestionDTO(ingestion, ingestion.Session))
+	}
+	return response, nil
+}
+
+// StartIngestionJob busca periódicamente sesiones terminadas sin resultados y los carga desde el provider
+func (s *ingestionService) StartIngestionJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if apiErr := s.RunPendingIngestions(ctx); apiErr != nil {
+				log.Printf("Ingestion job: error buscando sesiones pendientes: %v", apiErr)
+			}
+		}
+	}
+}
+
+// ingest hace un intento de carga de resultados y deja registrado el estado.
+// Si falla, programa el próximo intento con backoff exponencial o marca la ingesta como fallida.
+func (s *ingestionService) ingest(ctx context.Context, session *model.Session) (*model.SessionIngestion, e.ApiError) {
+	// 1. Obtener o crear el registro de ingesta
+	ingestion, apiErr := s.ingestionRepo.GetIngestionBySessionID(ctx, session.ID)
+	if apiErr != nil {
+		if apiErr.Status() != http.StatusNotFound {
+			return nil, apiErr
+		}
+		ingestion = &model.SessionIngestion{SessionID: session.ID}
+	}
+
+	// 2. Marcar el intento en curso
+	now := time.Now().UTC()
+	ingestion.Status = model.IngestionStatusRunning
+	ingestion.Attempts++
+	ingestion.LastAttemptAt = &now
+	ingestion.NextAttemptAt = nil
+	if apiErr := s.ingestionRepo.SaveIngestion(ctx, ingestion); apiErr != nil {
+		return nil, apiErr
+	}
+
+	// 3. Traer los resultados desde el provider
+	count, fetchErr := s.fetchSessionResults(ctx, session)
+
+	// 4. Registrar el resultado del intento
+	if fetchErr == nil {
+		completedAt := time.Now().UTC()
+		ingestion.Status = model.IngestionStatusSucceeded
+		ingestion.ResultsCount = count
+		ingestion.LastError = ""
+		ingestion.CompletedAt = &completedAt
+	} else {
+		ingestion.LastError = fetchErr.Error()
+		if ingestion.Attempts >= ingestionMaxAttempts {
+			ingestion.Status = model.IngestionStatusFailed
+		} else {
+			next := time.Now().UTC().Add(ingestionBackoff(ingestion.Attempts))
+			ingestion.Status = model.IngestionStatusPending
+			ingestion.NextAttemptAt = &next
+		}
+	}
+	if apiErr := s.ingestionRepo.SaveIngestion(ctx, ingestion); apiErr != nil {
+		return nil, apiErr
+	}
+	return ingestion, nil
+}
+
+// fetchSessionResults usa la ingesta de carrera o la de sesiones no Race según corresponda
+func (s *ingestionService) fetchSessionResults(ctx context.Context, session *model.Session) (int, error) {
+	var results []dto.ResponseResultDTO
+	var apiErr e.ApiError
+	if strings.EqualFold(session.SessionName, "race") || strings.EqualFold(session.SessionType, "race") {
+		results, apiErr = s.resultService.FetchResultsFromExternalAPI(ctx, session.ID)
+	} else {
+		results, apiErr = s.resultService.FetchNonRaceSessionResults(ctx, session.ID)
+	}
+	if apiErr != nil {
+		return 0, apiErr
+	}
+	if len(results) == 0 {
+		return 0, fmt.Errorf("el provider no devolvió resultados para la sesión %d", session.ID)
+	}
+	return len(results), nil
+}
+
+// ingestionBackoff devuelve la espera antes del próximo intento: 5m, 10m, 20m... hasta ingestionMaxBackoff
+func ingestionBackoff(attempts int) time.Duration {
+	backoff := ingestionBaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= ingestionMaxBackoff {
+			return ingestionMaxBackoff
+		}
+	}
+	return backoff
+}
+
+func toSessionIngestionDTO(ingestion *model.SessionIngestion, session *model.Session) dto.SessionIngestionDTO {
+	response := dto.SessionIngestionDTO{
+		SessionID:     ingestion.SessionID,
+		Status:        ingestion.Status,
+		Attempts:      ingestion.Attempts,
+		LastError:     ingestion.LastError,
+		ResultsCount:  ingestion.ResultsCount,
+		LastAttemptAt: ingestion.LastAttemptAt,
+		NextAttemptAt: ingestion.NextAttemptAt,
+		CompletedAt:   ingestion.CompletedAt,
+	}
+	if session != nil {
+		response.SessionName = session.SessionName
+		response.SessionType = session.SessionType
+		response.Location = session.Location
+		response.DateEnd = session.DateEnd
+	}
+	return response
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"prediapp.local/db/model"
+	"prediapp.local/racedata"
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// GetSessionLaps devuelve las vueltas de todos los pilotos de la sesión, agrupadas por piloto
+func (s *resultService) GetSessionLaps(ctx context.Context, sessionID int) (dto.SessionLapsDTO, e.ApiError) {
+	laps, apiErr := s.resultRepo.GetSessionLaps(ctx, sessionID)
+	if apiErr != nil {
+		return dto.SessionLapsDTO{}, apiErr
+	}
+	if len(laps) == 0 {
+		return dto.SessionLapsDTO{}, e.NewNotFoundApiError("No hay vueltas cargadas para esta sesión")
+	}
+
+	// Las vueltas vienen ordenadas por piloto y número de vuelta
+	response := dto.SessionLapsDTO{SessionID: sessionID, Drivers: []dto.DriverLapChartDTO{}}
+	start := 0
+	for i := 1; i <= len(laps); i++ {
+		if i == len(laps) || laps[i].DriverID != laps[start].DriverID {
+			response.Drivers = append(response.Drivers, toDriverLapChartDTO(sessionID, laps[start].Driver, laps[start:i]))
+			start = i
+		}
+	}
+	return response, nil
+}
+
+// GetDriverLapChart devuelve las vueltas de un piloto en la sesión
+func (s *resultService) GetDriverLapChart(ctx context.Context, sessionID, driverID int) (dto.DriverLapChartDTO, e.ApiError) {
+	laps, apiErr := s.resultRepo.GetDriverLaps(ctx, sessionID, driverID)
+	if apiErr != nil {
+		return dto.DriverLapChartDTO{}, apiErr
+	}
+	if len(laps) == 0 {
+		return dto.DriverLapChartDTO{}, e.NewNotFoundApiError("No hay vueltas cargadas para este piloto en esta sesión")
+	}
+	return toDriverLapChartDTO(sessionID, laps[0].Driver, laps), nil
+}
+
+// saveSessionTiming guarda las vueltas y las paradas en boxes ingeridas de la sesión.
+// Las vueltas de entrada a boxes se marcan con las paradas informadas por la API.
+func (s *resultService) saveSessionTiming(ctx context.Context, sessionID, sessionKey int, lapsByDriver map[int][]racedata.Lap, drivers map[int]dto.ResponseDriverDTO) e.ApiError {
+	// 1. Las paradas son opcionales: si la API falla se guardan las vueltas sin la marca de entrada a boxes
+	//    y se conservan las paradas que ya estaban cargadas
+	pitStops, err := s.raceData.GetPitStops(sessionKey)
+	if err != nil {
+		fmt.Printf("Error obteniendo paradas en boxes de la sesión %d: %v\n", sessionID, err)
+	}
+	pitInLaps := make(map[[2]int]bool, len(pitStops))
+	for _, pit := range pitStops {
+		pitInLaps[[2]int{pit.DriverNumber, pit.LapNumber}] = true
+	}
+
+	// 2. Armar las vueltas de los pilotos que se pudieron resolver
+	var laps []*model.Lap
+	for _, driverNumber := range sortedLapDrivers(lapsByDriver) {
+		driverInfo, ok := drivers[driverNumber]
+		if !ok {
+			continue
+		}
+		for _, lap := range lapsByDriver[driverNumber] {
+			laps = append(laps, toLapModel(lap, driverInfo.ID, pitInLaps[[2]int{driverNumber, lap.LapNumber}]))
+		}
+	}
+	if apiErr := s.resultRepo.ReplaceSessionLaps(ctx, sessionID, laps); apiErr != nil {
+		return apiErr
+	}
+
+	// 3. Reemplazar las paradas de la sesión, salvo que no se hayan podido obtener
+	if err != nil {
+		return nil
+	}
+	var stops []*model.PitStop
+	for _, pit := range pitStops {
+		driverInfo, ok := drivers[pit.DriverNumber]
+		if !ok {
+			continue
+		}
+		stops = append(stops, toPitStopModel(pit, driverInfo.ID))
+	}
+	return s.resultRepo.ReplaceSessionPitStops(ctx, sessionID, stops)
+}
+
+// sortedLapDrivers devuelve los driver_number con vueltas ordenados
+func sortedLapDrivers(lapsByDriver map[int][]racedata.Lap) []int {
+	numbers := make(map[int]*int, len(lapsByDriver))
+	for driverNumber := range lapsByDriver {
+		numbers[driverNumber] = nil
+	}
+	return sortedDriverNumbers(numbers)
+}
+
+func toLapModel(lap racedata.Lap, driverID int, pitIn bool) *model.Lap {
+	result := &model.Lap{
+		DriverID:    driverID,
+		LapNumber:   lap.LapNumber,
+		Sector1:     lap.DurationSector1,
+		Sector2:     lap.DurationSector2,
+		Sector3:     lap.DurationSector3,
+		IsPitInLap:  pitIn,
+		IsPitOutLap: lap.IsPitOutLap,
+	}
+	if lap.LapDuration > 0 {
+		duration := lap.LapDuration
+		result.LapDuration = &duration
+	}
+	if start, ok := parseRaceDataTime(lap.DateStart); ok {
+		result.DateStart = &start
+	}
+	return result
+}
+
+// toDriverLapChartDTO arma las vueltas de un piloto con su vuelta más rápida y el ritmo promedio
+func toDriverLapChartDTO(sessionID int, driver *model.Driver, laps []*model.Lap) dto.DriverLapChartDTO {
+	chart := dto.DriverLapChartDTO{SessionID: sessionID, Laps: make([]dto.LapDTO, 0, len(laps))}
+	if len(laps) > 0 {
+		chart.Driver = toDriverDTO(laps[0].DriverID, driver)
+	}
+
+	var total float64
+	var counted int
+	for _, lap := range laps {
+		chart.Laps = append(chart.Laps, dto.LapDTO{
+			LapNumber:   lap.LapNumber,
+			LapDuration: lap.LapDuration,
+			Sector1:     lap.Sector1,
+			Sector2:     lap.Sector2,
+			Sector3:     lap.Sector3,
+			IsPitInLap:  lap.IsPitInLap,
+			IsPitOutLap: lap.IsPitOutLap,
+			DateStart:   lap.DateStart,
+		})
+		if lap.LapNumber > chart.TotalLaps {
+			chart.TotalLaps = lap.LapNumber
+		}
+		if lap.LapDuration == nil {
+			continue
+		}
+		if chart.FastestLap == nil || *lap.LapDuration < *chart.FastestLap {
+			chart.FastestLap = lap.LapDuration
+			chart.FastestLapNumber = lap.LapNumber
+		}
+		if !lap.IsPitInLap && !lap.IsPitOutLap {
+			total += *lap.LapDuration
+			counted++
+		}
+	}
+	if counted > 0 {
+		average := total / float64(counted)
+		chart.AverageLap = &average
+	}
+	return chart
+}
+
+// toDriverDTO arma el DTO del piloto precargado; si no está precargado solo informa el ID
+func toDriverDTO(driverID int, driver *model.Driver) dto.ResponseDriverDTO {
+	if driver == nil {
+		return dto.ResponseDriverDTO{ID: driverID}
+	}
+	return dto.ResponseDriverDTO{
+		ID:           driver.ID,
+		DriverNumber: driver.DriverNumber,
+		FirstName:    driver.FirstName,
+		LastName:     driver.LastName,
+		FullName:     driver.FullName,
+		NameAcronym:  driver.NameAcronym,
+		TeamName:     driver.TeamName,
+	}
+}
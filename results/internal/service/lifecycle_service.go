@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"prediapp.local/db/model"
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// GetSessionResultStatus devuelve el estado de los resultados de una sesión y cuántas enmiendas tiene
+func (s *resultService) GetSessionResultStatus(ctx context.Context, sessionID int) (dto.SessionResultStatusDTO, e.ApiError) {
+	status, apiErr := s.getOrInitResultStatus(ctx, sessionID)
+	if apiErr != nil {
+		return dto.SessionResultStatusDTO{}, apiErr
+	}
+
+	amendments, apiErr := s.resultRepo.GetAmendmentsBySession(ctx, sessionID)
+	if apiErr != nil {
+		return dto.SessionResultStatusDTO{}, apiErr
+	}
+
+	response := toSessionResultStatusDTO(status)
+	response.Amendments = len(amendments)
+	return response, nil
+}
+
+// MarkSessionOfficial confirma los resultados de una sesión y dispara el puntaje de los prodes
+func (s *resultService) MarkSessionOfficial(ctx context.Context, sessionID int) (dto.SessionResultStatusDTO, e.ApiError) {
+	// 1. Debe haber resultados cargados
+	status, apiErr := s.getOrInitResultStatus(ctx, sessionID)
+	if apiErr != nil {
+		return dto.SessionResultStatusDTO{}, apiErr
+	}
+	if status.Status != model.ResultStatusProvisional {
+		return dto.SessionResultStatusDTO{}, e.NewConflictApiError("Los resultados de la sesión ya son oficiales")
+	}
+
+	// 2. Pasar a oficial
+	now := time.Now().UTC()
+	status.Status = model.ResultStatusOfficial
+	status.OfficialAt = &now
+	if apiErr := s.resultRepo.SaveSessionResultStatus(ctx, status); apiErr != nil {
+		return dto.SessionResultStatusDTO{}, apiErr
+	}
+
+	// 3. Puntuar los prodes de la sesión
+	response := toSessionResultStatusDTO(status)
+	s.rescoreSession(ctx, sessionID, &response)
+	return response, nil
+}
+
+// AmendResult modifica un resultado dejando registro del motivo y de los valores previos.
+// Si la sesión ya estaba puntuada (oficial o enmendada) pasa a "amended" y se recalculan los prodes.
+func (s *resultService) AmendResult(ctx context.Context, resultID int, request dto.AmendResultDTO) (dto.ResultAmendmentResponseDTO, e.ApiError) {
+	result, apiErr := s.resultRepo.GetResultByID(ctx, resultID)
+	if apiErr != nil {
+		return dto.ResultAmendmentResponseDTO{}, apiErr
+	}
+
+	change := dto.AmendResultChangeDTO{
+		ResultID:       result.ID,
+		Position:       request.Position,
+		Status:         request.Status,
+		FastestLapTime: request.FastestLapTime,
+	}
+	amendments, results, sessionStatus, apiErr := s.amendSessionResults(ctx, result.SessionID, []dto.AmendResultChangeDTO{change}, request.Reason)
+	if apiErr != nil {
+		return dto.ResultAmendmentResponseDTO{}, apiErr
+	}
+
+	return dto.ResultAmendmentResponseDTO{
+		Amendment:     toResultAmendmentDTO(amendments[0]),
+		Result:        toResponseResultDTO(results[0]),
+		SessionStatus: sessionStatus,
+	}, nil
+}
+
+// AmendSessionResults modifica varios resultados de una sesión en una sola enmienda, por ejemplo para
+// reclasificar la carrera tras una descalificación. La clasificación resultante se valida completa.
+func (s *resultService) AmendSessionResults(ctx context.Context, sessionID int, request dto.AmendSessionResultsDTO) (dto.SessionAmendmentResponseDTO, e.ApiError) {
+	amendments, results, sessionStatus, apiErr := s.amendSessionResults(ctx, sessionID, request.Changes, request.Reason)
+	if apiErr != nil {
+		return dto.SessionAmendmentResponseDTO{}, apiErr
+	}
+
+	response := dto.SessionAmendmentResponseDTO{
+		Amendments:    make([]dto.ResultAmendmentDTO, 0, len(amendments)),
+		Results:       make([]dto.ResponseResultDTO, 0, len(results)),
+		SessionStatus: sessionStatus,
+	}
+	for _, amendment := range amendments {
+		response.Amendments = append(response.Amendments, toResultAmendmentDTO(amendment))
+	}
+	for _, result := range results {
+		response.Results = append(response.Results, toResponseResultDTO(result))
+	}
+	return response, nil
+}
+
+// amendSessionResults aplica los cambios sobre la clasificación de la sesión, la valida entera y guarda
+// los resultados modificados con una enmienda por cada uno. Devuelve las enmiendas y los resultados modificados.
+func (s *resultService) amendSessionResults(ctx context.Context, sessionID int, changes []dto.AmendResultChangeDTO, reason string) ([]*model.ResultAmendment, []*model.Result, dto.SessionResultStatusDTO, e.ApiError) {
+	// 1. Validar el motivo
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, nil, dto.SessionResultStatusDTO{}, e.NewBadRequestApiError("Debe indicar el motivo de la enmienda")
+	}
+
+	// 2. Estado de la sesión
+	status, apiErr := s.getOrInitResultStatus(ctx, sessionID)
+	if apiErr != nil {
+		return nil, nil, dto.SessionResultStatusDTO{}, apiErr
+	}
+
+	// 3. Aplicar los cambios sobre la clasificación completa.
+	//    Un piloto que deja de estar clasificado (DSQ, DNF, DNS) pierde la posición.
+	results, apiErr := s.resultRepo.GetResultsBySessionID(ctx, sessionID)
+	if apiErr != nil {
+		return nil, nil, dto.SessionResultStatusDTO{}, apiErr
+	}
+	byID := make(map[int]*model.Result, len(results))
+	for _, result := range results {
+		byID[result.ID] = result
+	}
+
+	seen := make(map[int]bool, len(changes))
+	var amendments []*model.ResultAmendment
+	var changed []*model.Result
+	for _, change := range changes {
+		result, ok := byID[change.ResultID]
+		if !ok {
+			return nil, nil, dto.SessionResultStatusDTO{}, e.NewNotFoundApiError(fmt.Sprintf("El resultado %d no pertenece a la sesión %d", change.ResultID, sessionID))
+		}
+		if seen[change.ResultID] {
+			return nil, nil, dto.SessionResultStatusDTO{}, e.NewBadRequestApiError(fmt.Sprintf("El resultado %d aparece más de una vez en la enmienda", change.ResultID))
+		}
+		seen[change.ResultID] = true
+
+		amendment := &model.ResultAmendment{
+			SessionID:          result.SessionID,
+			ResultID:           result.ID,
+			DriverID:           result.DriverID,
+			PreviousPosition:   result.Position,
+			PreviousStatus:     result.Status,
+			PreviousFastestLap: result.FastestLapTime,
+			Reason:             reason,
+		}
+		if apiErr := applyResultChanges(result, change.Position, change.Status, change.FastestLapTime); apiErr != nil {
+			return nil, nil, dto.SessionResultStatusDTO{}, apiErr
+		}
+		if result.Status != "FINISHED" {
+			result.Position = nil
+		}
+		amendment.NewPosition = result.Position
+		amendment.NewStatus = result.Status
+		amendment.NewFastestLap = result.FastestLapTime
+
+		if equalPositions(amendment.PreviousPosition, amendment.NewPosition) &&
+			amendment.PreviousStatus == amendment.NewStatus &&
+			amendment.PreviousFastestLap == amendment.NewFastestLap {
+			continue
+		}
+		amendments = append(amendments, amendment)
+		changed = append(changed, result)
+	}
+	if len(amendments) == 0 {
+		return nil, nil, dto.SessionResultStatusDTO{}, e.NewBadRequestApiError("La enmienda no modifica el resultado")
+	}
+
+	// 4. La clasificación enmendada tiene que seguir siendo consistente.
+	//    La enmienda no cambia la cantidad de autos de la sesión, así que no se controla contra los participantes.
+	if violations := validateClassification(classificationEntries(results), 0); len(violations) > 0 {
+		return nil, nil, dto.SessionResultStatusDTO{}, classificationError(violations)
+	}
+
+	// 5. Una sesión ya puntuada pasa a enmendada
+	scored := status.Status != model.ResultStatusProvisional
+	if scored {
+		now := time.Now().UTC()
+		status.Status = model.ResultStatusAmended
+		status.AmendedAt = &now
+	}
+
+	// 6. Persistir resultados, enmiendas y estado juntos
+	if apiErr := s.resultRepo.AmendResults(ctx, changed, amendments, status); apiErr != nil {
+		return nil, nil, dto.SessionResultStatusDTO{}, apiErr
+	}
+	s.refreshSessionPoints(ctx, sessionID, changed...)
+
+	// 7. Recalcular los prodes si la sesión ya estaba puntuada
+	sessionStatus := toSessionResultStatusDTO(status)
+	if scored {
+		s.rescoreSession(ctx, sessionID, &sessionStatus)
+	}
+	return amendments, changed, sessionStatus, nil
+}
+
+// GetSessionAmendments devuelve el historial de enmiendas de una sesión
+func (s *resultService) GetSessionAmendments(ctx context.Context, sessionID int) ([]dto.ResultAmendmentDTO, e.ApiError) {
+	amendments, apiErr := s.resultRepo.GetAmendmentsBySession(ctx, sessionID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	response := make([]dto.ResultAmendmentDTO, 0, len(amendments))
+	for _, amendment := range amendments {
+		response = append(response, toResultAmendmentDTO(amendment))
+	}
+	return response, nil
+}
+
+// checkResultsEditable impide sobrescribir resultados oficiales; a partir de ahí los cambios van por enmienda
+func (s *resultService) checkResultsEditable(ctx context.Context, sessionID int) e.ApiError {
+	status, apiErr := s.resultRepo.GetSessionResultStatus(ctx, sessionID)
+	if apiErr != nil {
+		if apiErr.Status() == http.StatusNotFound {
+			return nil
+		}
+		return apiErr
+	}
+	if status.Status != model.ResultStatusProvisional {
+		return e.NewConflictApiError(fmt.Sprintf("Los resultados de la sesión %d son oficiales; use POST /results/:id/amendments con un motivo", sessionID))
+	}
+	return nil
+}
+
+// getOrInitResultStatus devuelve el estado de la sesión, creándolo como provisional si tiene resultados y no tiene estado
+func (s *resultService) getOrInitResultStatus(ctx context.Context, sessionID int) (*model.SessionResultStatus, e.ApiError) {
+	status, apiErr := s.resultRepo.GetSessionResultStatus(ctx, sessionID)
+	if apiErr == nil {
+		return status, nil
+	}
+	if apiErr.Status() != http.StatusNotFound {
+		return nil, apiErr
+	}
+
+	exists, apiErr := s.resultRepo.ExistsSessionInResults(ctx, sessionID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if !exists {
+		return nil, e.NewNotFoundApiError("La sesión no tiene resultados cargados")
+	}
+	if apiErr := s.resultRepo.EnsureProvisionalStatus(ctx, sessionID); apiErr != nil {
+		return nil, apiErr
+	}
+	return s.resultRepo.GetSessionResultStatus(ctx, sessionID)
+}
+
+// rescoreSession recalcula los props (en carreras) y pide a prodes que vuelva a puntuar la sesión.
+// Fantasy y survivor no se recalculan: sus jobs solo toman sesiones con resultados oficiales.
+// Los errores no deshacen el cambio de resultados: quedan en la respuesta para que el admin reintente.
+func (s *resultService) rescoreSession(ctx context.Context, sessionID int, response *dto.SessionResultStatusDTO) {
+	sessionData, err := s.sessionsClient.GetSessionByID(sessionID)
+	if err != nil {
+		response.RescoreError = err.Error()
+		log.Printf("Rescoring: error obteniendo la sesión %d: %v", sessionID, err)
+		return
+	}
+
+	isRace := strings.EqualFold(sessionData.SessionName, "race") || strings.EqualFold(sessionData.SessionType, "race")
+	if sessionData.SessionName == "Race" && sessionData.SessionType == "Race" { // Los props son solo de la carrera principal
+		if _, apiErr := s.ResolveSessionProps(ctx, sessionID); apiErr != nil {
+			log.Printf("Rescoring: error resolviendo props de la sesión %d: %v", sessionID, apiErr)
+		}
+	}
+
+	if err := s.prodesClient.RescoreSession(sessionID, isRace); err != nil {
+		response.RescoreError = err.Error()
+		log.Printf("Rescoring: %v", err)
+		return
+	}
+	response.Rescored = true
+}
+
+func equalPositions(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func toSessionResultStatusDTO(status *model.SessionResultStatus) dto.SessionResultStatusDTO {
+	return dto.SessionResultStatusDTO{
+		SessionID:  status.SessionID,
+		Status:     status.Status,
+		OfficialAt: status.OfficialAt,
+		AmendedAt:  status.AmendedAt,
+	}
+}
+
+func toResultAmendmentDTO(amendment *model.ResultAmendment) dto.ResultAmendmentDTO {
+	return dto.ResultAmendmentDTO{
+		ID:                 amendment.ID,
+		SessionID:          amendment.SessionID,
+		ResultID:           amendment.ResultID,
+		DriverID:           amendment.DriverID,
+		PreviousPosition:   amendment.PreviousPosition,
+		NewPosition:        amendment.NewPosition,
+		PreviousStatus:     amendment.PreviousStatus,
+		NewStatus:          amendment.NewStatus,
+		PreviousFastestLap: amendment.PreviousFastestLap,
+		NewFastestLap:      amendment.NewFastestLap,
+		Reason:             amendment.Reason,
+		CreatedAt:          amendment.CreatedAt,
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"prediapp.local/db/model"
+	"prediapp.local/results/internal/client"
+	"prediapp.local/results/internal/dto"
+	"prediapp.local/results/internal/repository"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// amendmentRepository es un ResultRepository en memoria con la clasificación de una sola sesión
+type amendmentRepository struct {
+	repository.ResultRepository
+	status     *model.SessionResultStatus
+	results    []*model.Result
+	amended    []*model.Result
+	amendments []*model.ResultAmendment
+}
+
+func (r *amendmentRepository) GetSessionResultStatus(ctx context.Context, sessionID int) (*model.SessionResultStatus, e.ApiError) {
+	return r.status, nil
+}
+
+func (r *amendmentRepository) GetResultsBySessionID(ctx context.Context, sessionID int) ([]*model.Result, e.ApiError) {
+	return r.results, nil
+}
+
+func (r *amendmentRepository) AmendResults(ctx context.Context, results []*model.Result, amendments []*model.ResultAmendment, status *model.SessionResultStatus) e.ApiError {
+	r.amended, r.amendments, r.status = results, amendments, status
+	return nil
+}
+
+func (r *amendmentRepository) UpdateSessionPoints(ctx context.Context, sessionID int, pointsByResult map[int]float64) e.ApiError {
+	return nil
+}
+
+// newAmendmentServer simula sessions (una clasificación, sin props) y el recálculo de prodes
+func newAmendmentServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`{"id": 1, "session_name": "Qualifying", "session_type": "Qualifying"}`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+}
+
+func TestAmendSessionResults(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test")
+	server := newAmendmentServer()
+	defer server.Close()
+
+	intPtr := func(v int) *int { return &v }
+	classification := func() []*model.Result {
+		return []*model.Result{
+			{ID: 1, SessionID: 1, DriverID: 10, Position: intPtr(1), Status: "FINISHED"},
+			{ID: 2, SessionID: 1, DriverID: 20, Position: intPtr(2), Status: "FINISHED"},
+			{ID: 3, SessionID: 1, DriverID: 30, Position: intPtr(3), Status: "FINISHED"},
+			{ID: 4, SessionID: 1, DriverID: 40, Status: "DNF"},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		status         string
+		reason         string
+		changes        []dto.AmendResultChangeDTO
+		wantErrStatus  int
+		wantAmendments int
+		wantStatus     string
+		wantRescored   bool
+	}{
+		{
+			name:           "provisional: se guarda sin recalcular prodes",
+			status:         model.ResultStatusProvisional,
+			reason:         "Penalización de 5 segundos",
+			changes:        []dto.AmendResultChangeDTO{{ResultID: 2, Position: intPtr(3)}, {ResultID: 3, Position: intPtr(2)}},
+			wantAmendments: 2,
+			wantStatus:     model.ResultStatusProvisional,
+		},
+		{
+			name:   "oficial: descalificación del ganador reclasifica y recalcula",
+			status: model.ResultStatusOfficial,
+			reason: "Fondo plano por debajo del mínimo",
+			changes: []dto.AmendResultChangeDTO{
+				{ResultID: 1, Status: "DSQ"}, {ResultID: 2, Position: intPtr(1)}, {ResultID: 3, Position: intPtr(2)},
+			},
+			wantAmendments: 3,
+			wantStatus:     model.ResultStatusAmended,
+			wantRescored:   true,
+		},
+		{
+			name:           "enmendada: otra enmienda sigue permitida",
+			status:         model.ResultStatusAmended,
+			reason:         "Vuelta rápida anulada",
+			changes:        []dto.AmendResultChangeDTO{{ResultID: 3, FastestLapTime: 91.5}},
+			wantAmendments: 1,
+			wantStatus:     model.ResultStatusAmended,
+			wantRescored:   true,
+		},
+		{
+			name:          "sin motivo",
+			status:        model.ResultStatusOfficial,
+			reason:        "  ",
+			changes:       []dto.AmendResultChangeDTO{{ResultID: 3, Status: "DSQ"}},
+			wantErrStatus: http.StatusBadRequest,
+		},
+		{
+			name:          "resultado de otra sesión",
+			status:        model.ResultStatusOfficial,
+			reason:        "Error de carga",
+			changes:       []dto.AmendResultChangeDTO{{ResultID: 99, Status: "DSQ"}},
+			wantErrStatus: http.StatusNotFound,
+		},
+		{
+			name:          "resultado repetido",
+			status:        model.ResultStatusOfficial,
+			reason:        "Error de carga",
+			changes:       []dto.AmendResultChangeDTO{{ResultID: 3, Status: "DSQ"}, {ResultID: 3, Status: "DNF"}},
+			wantErrStatus: http.StatusBadRequest,
+		},
+		{
+			name:          "sin cambios",
+			status:        model.ResultStatusOfficial,
+			reason:        "Error de carga",
+			changes:       []dto.AmendResultChangeDTO{{ResultID: 1, Position: intPtr(1)}},
+			wantErrStatus: http.StatusBadRequest,
+		},
+		{
+			name:          "deja un hueco en la clasificación",
+			status:        model.ResultStatusOfficial,
+			reason:        "Descalificación",
+			changes:       []dto.AmendResultChangeDTO{{ResultID: 1, Status: "DSQ"}},
+			wantErrStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &amendmentRepository{
+				status:  &model.SessionResultStatus{SessionID: 1, Status: tt.status},
+				results: classification(),
+			}
+			s := &resultService{
+				resultRepo:     repo,
+				sessionsClient: client.NewHttpClient(server.URL),
+				prodesClient:   client.NewHttpClient(server.URL),
+			}
+
+			amendments, _, sessionStatus, apiErr := s.amendSessionResults(context.Background(), 1, tt.changes, tt.reason)
+			if tt.wantErrStatus != 0 {
+				if apiErr == nil || apiErr.Status() != tt.wantErrStatus {
+					t.Fatalf("amendSessionResults() error = %v, want status %d", apiErr, tt.wantErrStatus)
+				}
+				if repo.amended != nil {
+					t.Errorf("una enmienda rechazada no debe guardarse")
+				}
+				return
+			}
+			if apiErr != nil {
+				t.Fatalf("amendSessionResults() error = %v", apiErr)
+			}
+			if len(amendments) != tt.wantAmendments || len(repo.amendments) != tt.wantAmendments {
+				t.Errorf("enmiendas = %d (guardadas %d), want %d", len(amendments), len(repo.amendments), tt.wantAmendments)
+			}
+			if sessionStatus.Status != tt.wantStatus || repo.status.Status != tt.wantStatus {
+				t.Errorf("status = %q (guardado %q), want %q", sessionStatus.Status, repo.status.Status, tt.wantStatus)
+			}
+			if sessionStatus.Rescored != tt.wantRescored {
+				t.Errorf("rescored = %v (%s), want %v", sessionStatus.Rescored, sessionStatus.RescoreError, tt.wantRescored)
+			}
+			for _, amendment := range amendments {
+				if amendment.Reason != tt.reason {
+					t.Errorf("motivo = %q, want %q", amendment.Reason, tt.reason)
+				}
+				if amendment.NewStatus != "FINISHED" && amendment.NewPosition != nil {
+					t.Errorf("el resultado %d queda %s con posición %d", amendment.ResultID, amendment.NewStatus, *amendment.NewPosition)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"prediapp.local/db/model"
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// liveGrace es cuánto se sigue sondeando una sesión después de su date_end: las últimas posiciones llegan
+// después de la bandera y quedan disponibles hasta que se cargan los resultados
+const liveGrace = 30 * time.Minute
+
+// liveStore guarda en memoria las posiciones provisorias de las sesiones en curso (sessionID -> posiciones)
+type liveStore struct {
+	mu        sync.RWMutex
+	standings map[int]dto.LiveStandingsDTO
+}
+
+func newLiveStore() *liveStore {
+	return &liveStore{standings: make(map[int]dto.LiveStandingsDTO)}
+}
+
+func (l *liveStore) get(sessionID int) (dto.LiveStandingsDTO, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	standings, ok := l.standings[sessionID]
+	return standings, ok
+}
+
+func (l *liveStore) set(standings dto.LiveStandingsDTO) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.standings[standings.SessionID] = standings
+}
+
+// retain descarta las sesiones que ya no están en vivo
+func (l *liveStore) retain(sessionIDs map[int]bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for sessionID := range l.standings {
+		if !sessionIDs[sessionID] {
+			delete(l.standings, sessionID)
+		}
+	}
+}
+
+// GetLiveStandings devuelve las posiciones provisorias de una sesión en curso según el último sondeo
+func (s *resultService) GetLiveStandings(ctx context.Context, sessionID int) (dto.LiveStandingsDTO, e.ApiError) {
+	standings, ok := s.live.get(sessionID)
+	if !ok {
+		return dto.LiveStandingsDTO{}, e.NewNotFoundApiError(fmt.Sprintf("No hay posiciones en vivo para la sesión %d", sessionID))
+	}
+	return standings, nil
+}
+
+// RefreshLiveStandings sondea las posiciones de todas las sesiones en curso y reemplaza las guardadas en memoria.
+// Si el provider falla en una sesión se conservan las posiciones del sondeo anterior.
+func (s *resultService) RefreshLiveStandings(ctx context.Context) e.ApiError {
+	now := time.Now().UTC()
+	sessions, apiErr := s.resultRepo.GetLiveSessions(ctx, now, liveGrace)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	live := make(map[int]bool, len(sessions))
+	for _, session := range sessions {
+		if ctx.Err() != nil {
+			return nil
+		}
+		live[session.ID] = true
+		standings, err := s.pollLiveStandings(session, now)
+		if err != nil {
+			log.Printf("Live job: error obteniendo posiciones de la sesión %d: %v", session.ID, err)
+			continue
+		}
+		s.live.set(standings)
+	}
+	s.live.retain(live)
+	return nil
+}
+
+// StartLiveJob sondea periódicamente las posiciones de las sesiones en curso
+func (s *resultService) StartLiveJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if apiErr := s.RefreshLiveStandings(ctx); apiErr != nil {
+				log.Printf("Live job: error buscando sesiones en curso: %v", apiErr)
+			}
+		}
+	}
+}
+
+// pollLiveStandings arma las posiciones de una sesión con la última posición informada de cada piloto
+func (s *resultService) pollLiveStandings(session *model.Session, now time.Time) (dto.LiveStandingsDTO, error) {
+	positions, err := s.raceData.GetPositions(*session.SessionKey)
+	if err != nil {
+		return dto.LiveStandingsDTO{}, err
+	}
+	finalPositions := finalPositionsByDriver(positions)
+	drivers := s.getDriversByNumber(sortedDriverNumbers(finalPositions))
+
+	standings := dto.LiveStandingsDTO{
+		SessionID:   session.ID,
+		SessionName: session.SessionName,
+		SessionType: session.SessionType,
+		Provisional: true,
+		Finished:    !session.DateEnd.After(now),
+		UpdatedAt:   now,
+		Positions:   make([]dto.LivePositionDTO, 0, len(finalPositions)),
+	}
+	for driverNumber, position := range finalPositions {
+		if position == nil {
+			continue
+		}
+		driver, ok := drivers[driverNumber]
+		if !ok {
+			driver = dto.ResponseDriverDTO{DriverNumber: driverNumber}
+		}
+		standings.Positions = append(standings.Positions, dto.LivePositionDTO{
+			Position: *position,
+			Driver:   driver,
+		})
+	}
+	sort.Slice(standings.Positions, func(i, j int) bool {
+		return standings.Positions[i].Position < standings.Positions[j].Position
+	})
+	return standings, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"prediapp.local/db/model"
+	"prediapp.local/racedata"
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// GetSessionPitStops devuelve las paradas en boxes de la sesión y el tiempo promedio de parada de cada equipo
+func (s *resultService) GetSessionPitStops(ctx context.Context, sessionID int) (dto.SessionPitStopsDTO, e.ApiError) {
+	pitStops, apiErr := s.resultRepo.GetSessionPitStops(ctx, sessionID)
+	if apiErr != nil {
+		return dto.SessionPitStopsDTO{}, apiErr
+	}
+
+	response := dto.SessionPitStopsDTO{
+		SessionID:  sessionID,
+		TotalStops: len(pitStops),
+		PitStops:   make([]dto.PitStopDTO, 0, len(pitStops)),
+		Teams:      []dto.TeamPitStopsDTO{},
+	}
+
+	// 1. Listar las paradas numerándolas por piloto
+	stopsByDriver := make(map[int]int)
+	teams := make(map[string]*teamPitStats)
+	for _, pitStop := range pitStops {
+		stopsByDriver[pitStop.DriverID]++
+		driver := toDriverDTO(pitStop.DriverID, pitStop.Driver)
+		response.PitStops = append(response.PitStops, dto.PitStopDTO{
+			Driver:      driver,
+			StopNumber:  stopsByDriver[pitStop.DriverID],
+			LapNumber:   pitStop.LapNumber,
+			PitDuration: pitStop.PitDuration,
+			Date:        pitStop.Date,
+		})
+
+		// 2. Acumular por equipo
+		stats, ok := teams[driver.TeamName]
+		if !ok {
+			stats = &teamPitStats{}
+			teams[driver.TeamName] = stats
+		}
+		stats.add(pitStop.PitDuration)
+	}
+
+	// 3. Promedio por equipo, de la parada promedio más rápida a la más lenta
+	for teamName, stats := range teams {
+		response.Teams = append(response.Teams, stats.toDTO(teamName))
+	}
+	sort.Slice(response.Teams, func(i, j int) bool {
+		a, b := response.Teams[i].AverageDuration, response.Teams[j].AverageDuration
+		if a == nil || b == nil {
+			return a != nil || (b == nil && response.Teams[i].TeamName < response.Teams[j].TeamName)
+		}
+		return *a < *b
+	})
+
+	return response, nil
+}
+
+// teamPitStats acumula las paradas de un equipo
+type teamPitStats struct {
+	stops   int
+	timed   int
+	total   float64
+	fastest *float64
+}
+
+func (t *teamPitStats) add(duration *float64) {
+	t.stops++
+	if duration == nil {
+		return
+	}
+	t.timed++
+	t.total += *duration
+	if t.fastest == nil || *duration < *t.fastest {
+		t.fastest = duration
+	}
+}
+
+func (t *teamPitStats) toDTO(teamName string) dto.TeamPitStopsDTO {
+	team := dto.TeamPitStopsDTO{TeamName: teamName, Stops: t.stops, FastestDuration: t.fastest}
+	if t.timed > 0 {
+		average := t.total / float64(t.timed)
+		team.AverageDuration = &average
+	}
+	return team
+}
+
+func toPitStopModel(pit racedata.PitStop, driverID int) *model.PitStop {
+	pitStop := &model.PitStop{
+		DriverID:    driverID,
+		LapNumber:   pit.LapNumber,
+		PitDuration: pit.PitDuration,
+	}
+	if date, ok := parseRaceDataTime(pit.Date); ok {
+		pitStop.Date = &date
+	}
+	return pitStop
+}
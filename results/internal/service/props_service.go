@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"prediapp.local/db/model"
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// propResolver calcula el valor real de un prop a partir de los resultados guardados y los datos de la API externa
+type propResolver func(ctx context.Context, s *resultService, sessionKey int, results []*model.Result) (string, error)
+
+// Resolvers por código de prop. Un prop sin resolver (por ejemplo, uno creado desde el admin) queda pendiente
+// de carga manual con SetSessionPropAnswer.
+var propResolvers = map[string]propResolver{
+	"pit_stops":        resolvePitStops,
+	"winning_margin":   resolveWinningMargin,
+	"first_retirement": resolveFirstRetirement,
+	"red_flag":         resolveRedFlag,
+	"rain":             resolveRain,
+}
+
+// ResolveSessionProps calcula y guarda el valor real de cada prop activo para una carrera.
+// Los valores cargados a mano no se pisan.
+func (s *resultService) ResolveSessionProps(ctx context.Context, sessionID int) ([]dto.PropResultDTO, e.ApiError) {
+	if apiErr := s.checkPropsSession(sessionID); apiErr != nil {
+		return nil, apiErr
+	}
+
+	// 1. Los props se resuelven sobre los resultados ya cargados
+	results, apiErr := s.resultRepo.GetResultsOrderedByPosition(ctx, sessionID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if len(results) == 0 {
+		return nil, e.NewNotFoundApiError("No hay resultados cargados para la sesión, no se pueden resolver los props")
+	}
+
+	// 2. Obtener sessionKey para consultar la API externa
+	sessionKey, err := s.sessionsClient.GetSessionKeyBySessionID(sessionID)
+	if err != nil {
+		return nil, e.NewInternalServerApiError("Error obteniendo session key", err)
+	}
+
+	props, apiErr := s.resultRepo.GetActiveProps(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	stored, apiErr := s.resultRepo.GetSessionPropResults(ctx, sessionID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	manualAnswers := make(map[int]string)
+	for _, pr := range stored {
+		if pr.Manual {
+			manualAnswers[pr.PropID] = pr.Answer
+		}
+	}
+
+	// 3. Resolver cada prop que tenga un resolver registrado y no tenga un valor manual
+	var propResults []*model.SessionPropResult
+	var response []dto.PropResultDTO
+	for _, prop := range props {
+		if answer, ok := manualAnswers[prop.ID]; ok {
+			response = append(response, dto.PropResultDTO{PropID: prop.ID, Code: prop.Code, Name: prop.Name, Answer: answer, Manual: true})
+			continue
+		}
+		resolver, ok := propResolvers[prop.Code]
+		if !ok {
+			fmt.Printf("Prop %s no tiene resolver automático, queda pendiente de carga manual\n", prop.Code)
+			continue
+		}
+
+		answer, err := resolver(ctx, s, sessionKey, results)
+		if err != nil {
+			return nil, e.NewInternalServerApiError(fmt.Sprintf("Error resolviendo el prop %s", prop.Code), err)
+		}
+
+		propResults = append(propResults, &model.SessionPropResult{PropID: prop.ID, Answer: answer})
+		response = append(response, dto.PropResultDTO{PropID: prop.ID, Code: prop.Code, Name: prop.Name, Answer: answer})
+	}
+
+	// 4. Persistir
+	if apiErr := s.resultRepo.SaveSessionPropResults(ctx, sessionID, propResults); apiErr != nil {
+		return nil, apiErr
+	}
+
+	return response, nil
+}
+
+// SetSessionPropAnswer guarda a mano el valor real de un prop (props sin resolver automático o correcciones).
+// Si los resultados de la sesión ya son oficiales se vuelven a puntuar los prodes.
+func (s *resultService) SetSessionPropAnswer(ctx context.Context, sessionID int, propID int, answer string) (dto.PropResultDTO, e.ApiError) {
+	if apiErr := s.checkPropsSession(sessionID); apiErr != nil {
+		return dto.PropResultDTO{}, apiErr
+	}
+
+	prop, apiErr := s.resultRepo.GetPropByID(ctx, propID)
+	if apiErr != nil {
+		return dto.PropResultDTO{}, apiErr
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if !validPropValue(prop.AnswerType, answer) {
+		return dto.PropResultDTO{}, e.NewBadRequestApiError(fmt.Sprintf("Valor inválido para el prop %s: se esperaba un valor de tipo %s", prop.Code, prop.AnswerType))
+	}
+
+	propResult := &model.SessionPropResult{PropID: prop.ID, Answer: answer, Manual: true}
+	if apiErr := s.resultRepo.SaveSessionPropResults(ctx, sessionID, []*model.SessionPropResult{propResult}); apiErr != nil {
+		return dto.PropResultDTO{}, apiErr
+	}
+
+	status, apiErr := s.resultRepo.GetSessionResultStatus(ctx, sessionID)
+	if apiErr == nil && status.Status != model.ResultStatusProvisional {
+		if err := s.prodesClient.RescoreSession(sessionID, true); err != nil {
+			log.Printf("Prop manual: error repuntuando la sesión %d: %v", sessionID, err)
+		}
+	}
+
+	return dto.PropResultDTO{PropID: prop.ID, Code: prop.Code, Name: prop.Name, Answer: answer, Manual: true}, nil
+}
+
+// checkPropsSession verifica que la sesión sea una carrera: los props solo se responden en el ProdeCarrera
+func (s *resultService) checkPropsSession(sessionID int) e.ApiError {
+	session, err := s.sessionsClient.GetSessionByID(sessionID)
+	if err != nil {
+		return e.NewInternalServerApiError("Error obteniendo la sesión", err)
+	}
+	if session.SessionName != "Race" || session.SessionType != "Race" {
+		return e.NewBadRequestApiError("Los props solo se resuelven en sesiones de tipo 'Race'")
+	}
+	return nil
+}
+
+// validPropValue verifica que el valor real respete el tipo del prop
+func validPropValue(answerType string, value string) bool {
+	switch answerType {
+	case model.PropAnswerBoolean:
+		return value == "true" || value == "false"
+	case model.PropAnswerInteger, model.PropAnswerDriver:
+		n, err := strconv.Atoi(value)
+		return err == nil && n >= 0
+	case model.PropAnswerNumber:
+		f, err := strconv.ParseFloat(value, 64)
+		return err == nil && f >= 0
+	}
+	return false
+}
+
+// GetSessionPropResults devuelve los props ya resueltos de una sesión
+func (s *resultService) GetSessionPropResults(ctx context.Context, sessionID int) ([]dto.PropResultDTO, e.ApiError) {
+	propResults, apiErr := s.resultRepo.GetSessionPropResults(ctx, sessionID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	response := make([]dto.PropResultDTO, 0, len(propResults))
+	for _, pr := range propResults {
+		item := dto.PropResultDTO{PropID: pr.PropID, Answer: pr.Answer, Manual: pr.Manual}
+		if pr.Prop != nil {
+			item.Code = pr.Prop.Code
+			item.Name = pr.Prop.Name
+		}
+		response = append(response, item)
+	}
+	return response, nil
+}
+
+// resolvePitStops cuenta todas las paradas en boxes de la carrera. Usa las paradas ya ingeridas
+// y solo consulta la API externa si la sesión todavía no tiene paradas guardadas.
+func resolvePitStops(ctx context.Context, s *resultService, sessionKey int, results []*model.Result) (string, error) {
+	if len(results) > 0 {
+		stored, apiErr := s.resultRepo.GetSessionPitStops(ctx, results[0].SessionID)
+		if apiErr != nil {
+			return "", apiErr
+		}
+		if len(stored) > 0 {
+			return strconv.Itoa(len(stored)), nil
+		}
+	}
+
+	pitStops, err := s.raceData.GetPitStops(sessionKey)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(len(pitStops)), nil
+}
+
+// resolveRedFlag indica si hubo al menos una bandera roja
+func resolveRedFlag(ctx context.Context, s *resultService, sessionKey int, results []*model.Result) (string, error) {
+	events, err := s.raceData.GetRaceControl(sessionKey)
+	if err != nil {
+		return "", err
+	}
+	for _, event := range events {
+		if event.Flag == "RED" {
+			return "true", nil
+		}
+	}
+	return "false", nil
+}
+
+// resolveRain indica si llovió en algún momento de la carrera. Usa el clima ya cargado de la sesión
+// y solo consulta la API externa si todavía no tiene mediciones guardadas.
+func resolveRain(ctx context.Context, s *resultService, sessionKey int, results []*model.Result) (string, error) {
+	if len(results) > 0 {
+		stored, apiErr := s.resultRepo.GetSessionWeather(ctx, results[0].SessionID)
+		if apiErr != nil {
+			return "", apiErr
+		}
+		if len(stored) > 0 {
+			for _, sample := range stored {
+				if sample.Rainfall {
+					return "true", nil
+				}
+			}
+			return "false", nil
+		}
+	}
+
+	weather, err := s.raceData.GetWeather(sessionKey)
+	if err != nil {
+		return "", err
+	}
+	if len(weather) == 0 {
+		return "", fmt.Errorf("no hay mediciones de clima para la sesión %d", sessionKey)
+	}
+	for _, w := range weather {
+		if w.Rainfall > 0 {
+			return "true", nil
+		}
+	}
+	return "false", nil
+}
+
+// resolveWinningMargin toma el último gap al líder reportado para el piloto que terminó segundo
+func resolveWinningMargin(ctx context.Context, s *resultService, sessionKey int, results []*model.Result) (string, error) {
+	var second *model.Result
+	for _, r := range results {
+		if r.Position != nil && *r.Position == 2 {
+			second = r
+			break
+		}
+	}
+	if second == nil || second.Driver == nil {
+		return "", fmt.Errorf("no se encontró el piloto en P2")
+	}
+
+	intervals, err := s.raceData.GetIntervals(sessionKey, second.Driver.DriverNumber)
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].Date < intervals[j].Date
+	})
+
+	// Recorremos desde el final buscando el último gap numérico
+	for i := len(intervals) - 1; i >= 0; i-- {
+		var gap float64
+		if err := json.Unmarshal(intervals[i].GapToLeader, &gap); err == nil {
+			return strconv.FormatFloat(gap, 'f', 3, 64), nil
+		}
+	}
+	return "", fmt.Errorf("no hay gap numérico para el driver %d", second.Driver.DriverNumber)
+}
+
+// resolveFirstRetirement devuelve el driver_id del DNF con menos vueltas completadas ("0" si nadie abandonó).
+// Usa las vueltas guardadas en la ingesta, sin volver a consultar al provider.
+func resolveFirstRetirement(ctx context.Context, s *resultService, sessionKey int, results []*model.Result) (string, error) {
+	firstDriverID := 0
+	minLaps := -1
+	for _, r := range results {
+		if r.Status != "DNF" {
+			continue
+		}
+		if minLaps == -1 || r.LapsCompleted < minLaps {
+			minLaps = r.LapsCompleted
+			firstDriverID = r.DriverID
+		}
+	}
+	return strconv.Itoa(firstDriverID), nil
+}
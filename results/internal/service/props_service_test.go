@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"prediapp.local/db/model"
+)
+
+func TestResolveFirstRetirement(t *testing.T) {
+	result := func(driverID int, status string, laps int) *model.Result {
+		return &model.Result{DriverID: driverID, Status: status, LapsCompleted: laps}
+	}
+
+	tests := []struct {
+		name    string
+		results []*model.Result
+		want    string
+	}{
+		{
+			name:    "sin abandonos",
+			results: []*model.Result{result(1, "FINISHED", 57), result(2, "FINISHED", 56)},
+			want:    "0",
+		},
+		{
+			name:    "el DNF con menos vueltas",
+			results: []*model.Result{result(1, "FINISHED", 57), result(2, "DNF", 30), result(3, "DNF", 12)},
+			want:    "3",
+		},
+		{
+			name:    "DNS y DSQ no cuentan como abandono",
+			results: []*model.Result{result(1, "DNS", 0), result(2, "DSQ", 5), result(3, "DNF", 40)},
+			want:    "3",
+		},
+		{
+			name:    "empate queda el primero",
+			results: []*model.Result{result(4, "DNF", 1), result(5, "DNF", 1)},
+			want:    "4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveFirstRetirement(context.Background(), nil, 0, tt.results)
+			if err != nil {
+				t.Fatalf("resolveFirstRetirement() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveFirstRetirement() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
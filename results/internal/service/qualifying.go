@@ -0,0 +1,193 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"prediapp.local/db/model"
+	"prediapp.local/racedata"
+)
+
+// qualifyingBreak es el tiempo sin vueltas que separa dos tandas cuando dirección de carrera no informa la tanda
+const qualifyingBreak = 5 * time.Minute
+
+// stoppage es un período con bandera roja; end queda en cero si no se informó la reanudación
+type stoppage struct {
+	start, end time.Time
+}
+
+// driverQualifying resume la clasificación de un piloto: mejor vuelta de cada tanda y última tanda disputada
+type driverQualifying struct {
+	Best    [3]*float64 // Q1, Q2 y Q3 (nil si no marcó tiempo en la tanda)
+	Segment int         // 1, 2 o 3; 0 si no dio vueltas
+}
+
+// isQualifyingSession indica si la sesión es una clasificación por tandas (qualy, sprint qualy o shootout)
+func isQualifyingSession(sessionName, sessionType string) bool {
+	name := strings.ToLower(sessionName)
+	return strings.EqualFold(sessionType, "qualifying") || strings.Contains(name, "qualifying") || strings.Contains(name, "shootout")
+}
+
+// qualifyingSegmentStarts devuelve el inicio de Q2 y Q3. Usa la tanda que informa dirección de carrera
+// (qualifying_phase); si no viene, toma como cortes los primeros baches de más de qualifyingBreak sin vueltas.
+// El tiempo bajo bandera roja no cuenta para el bache: una interrupción no es un cambio de tanda.
+func qualifyingSegmentStarts(events []racedata.RaceControlEvent, lapsByDriver map[int][]racedata.Lap) []time.Time {
+	phaseStarts := make(map[int]time.Time)
+	for _, event := range events {
+		if event.QualifyingPhase == nil || *event.QualifyingPhase < 2 {
+			continue
+		}
+		date, ok := parseRaceDataTime(event.Date)
+		if !ok {
+			continue
+		}
+		if start, seen := phaseStarts[*event.QualifyingPhase]; !seen || date.Before(start) {
+			phaseStarts[*event.QualifyingPhase] = date
+		}
+	}
+	if q2, ok := phaseStarts[2]; ok {
+		starts := []time.Time{q2}
+		if q3, ok := phaseStarts[3]; ok {
+			starts = append(starts, q3)
+		}
+		return starts
+	}
+
+	var lapStarts []time.Time
+	for _, laps := range lapsByDriver {
+		for _, lap := range laps {
+			if start, ok := parseRaceDataTime(lap.DateStart); ok {
+				lapStarts = append(lapStarts, start)
+			}
+		}
+	}
+	sort.Slice(lapStarts, func(i, j int) bool { return lapStarts[i].Before(lapStarts[j]) })
+
+	stoppages := redFlagStoppages(events)
+	var starts []time.Time
+	for i := 1; i < len(lapStarts) && len(starts) < 2; i++ {
+		if lapStarts[i].Sub(lapStarts[i-1])-stoppedTime(stoppages, lapStarts[i-1], lapStarts[i]) > qualifyingBreak {
+			starts = append(starts, lapStarts[i])
+		}
+	}
+	return starts
+}
+
+// redFlagStoppages arma los períodos con bandera roja: cada uno termina con la siguiente bandera verde
+// o con el aviso de apertura de la salida de boxes
+func redFlagStoppages(events []racedata.RaceControlEvent) []stoppage {
+	type flagEvent struct {
+		date time.Time
+		red  bool
+	}
+	var flags []flagEvent
+	for _, event := range events {
+		date, ok := parseRaceDataTime(event.Date)
+		if !ok {
+			continue
+		}
+		message := strings.ToUpper(event.Message)
+		switch {
+		case strings.EqualFold(event.Flag, "RED"):
+			flags = append(flags, flagEvent{date: date, red: true})
+		case strings.EqualFold(event.Flag, "GREEN") || strings.Contains(message, "PIT EXIT OPEN"):
+			flags = append(flags, flagEvent{date: date})
+		}
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].date.Before(flags[j].date) })
+
+	var stoppages []stoppage
+	for _, flag := range flags {
+		open := len(stoppages) > 0 && stoppages[len(stoppages)-1].end.IsZero()
+		switch {
+		case flag.red && !open:
+			stoppages = append(stoppages, stoppage{start: flag.date})
+		case !flag.red && open:
+			stoppages[len(stoppages)-1].end = flag.date
+		}
+	}
+	return stoppages
+}
+
+// stoppedTime devuelve cuánto del intervalo entre from y to estuvo bajo bandera roja
+func stoppedTime(stoppages []stoppage, from, to time.Time) time.Duration {
+	var stopped time.Duration
+	for _, stop := range stoppages {
+		start, end := stop.start, stop.end
+		if end.IsZero() || end.After(to) {
+			end = to
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(start) {
+			stopped += end.Sub(start)
+		}
+	}
+	return stopped
+}
+
+// summarizeQualifying reparte las vueltas de cada piloto en tandas y se queda con la mejor de cada una
+func summarizeQualifying(lapsByDriver map[int][]racedata.Lap, segmentStarts []time.Time) map[int]driverQualifying {
+	summaries := make(map[int]driverQualifying, len(lapsByDriver))
+	for driverNumber, laps := range lapsByDriver {
+		var summary driverQualifying
+		for _, lap := range laps {
+			segment := 1
+			if start, ok := parseRaceDataTime(lap.DateStart); ok {
+				for _, segmentStart := range segmentStarts {
+					if !start.Before(segmentStart) {
+						segment++
+					}
+				}
+			}
+			if segment > summary.Segment {
+				summary.Segment = segment
+			}
+			if lap.LapDuration <= 0 || lap.IsPitOutLap {
+				continue
+			}
+			if best := summary.Best[segment-1]; best == nil || lap.LapDuration < *best {
+				duration := lap.LapDuration
+				summary.Best[segment-1] = &duration
+			}
+		}
+		summaries[driverNumber] = summary
+	}
+	return summaries
+}
+
+// classifyQualifyingResults completa las tandas de cada resultado y la diferencia con la pole.
+// results y driverNumbers van en paralelo. La diferencia se mide en la última tanda que disputó el piloto
+// contra el tiempo del poleman en esa misma tanda.
+func classifyQualifyingResults(results []*model.Result, driverNumbers []int, summaries map[int]driverQualifying) {
+	var pole driverQualifying
+	for i, result := range results {
+		if result.Position != nil && *result.Position == 1 {
+			pole = summaries[driverNumbers[i]]
+		}
+	}
+
+	for i, result := range results {
+		summary := summaries[driverNumbers[i]]
+		result.Q1Time, result.Q2Time, result.Q3Time = summary.Best[0], summary.Best[1], summary.Best[2]
+		result.KnockoutSegment = ""
+		result.GapToLeader = nil
+		if summary.Segment == 0 {
+			continue
+		}
+		result.KnockoutSegment = fmt.Sprintf("Q%d", summary.Segment)
+
+		if result.Position != nil && *result.Position == 1 {
+			continue
+		}
+		best, poleBest := summary.Best[summary.Segment-1], pole.Best[summary.Segment-1]
+		if best != nil && poleBest != nil {
+			gap := math.Round((*best-*poleBest)*1000) / 1000 // Los tiempos vienen al milésimo
+			result.GapToLeader = &gap
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// GetQualifyingSheet devuelve la hoja de tiempos de una clasificación: Q1, Q2 y Q3 de cada piloto,
+// tanda en la que quedó eliminado y diferencia con la pole
+func (s *resultService) GetQualifyingSheet(ctx context.Context, sessionID int) (dto.QualifyingSheetDTO, e.ApiError) {
+	results, apiErr := s.resultRepo.GetResultsOrderedByPosition(ctx, sessionID)
+	if apiErr != nil {
+		return dto.QualifyingSheetDTO{}, apiErr
+	}
+	if len(results) == 0 {
+		return dto.QualifyingSheetDTO{}, e.NewNotFoundApiError("No se encontraron resultados para la sesión")
+	}
+
+	sheet := dto.QualifyingSheetDTO{SessionID: sessionID, Entries: make([]dto.QualifyingEntryDTO, 0, len(results))}
+	if session := results[0].Session; session != nil {
+		if !isQualifyingSession(session.SessionName, session.SessionType) {
+			return dto.QualifyingSheetDTO{}, e.NewBadRequestApiError("La sesión no es una clasificación")
+		}
+		sheet.SessionName = session.SessionName
+	}
+
+	for _, result := range results {
+		entry := dto.QualifyingEntryDTO{
+			Position:        result.Position,
+			Driver:          toDriverDTO(result.DriverID, result.Driver),
+			Q1Time:          result.Q1Time,
+			Q2Time:          result.Q2Time,
+			Q3Time:          result.Q3Time,
+			BestLap:         result.FastestLapTime,
+			KnockoutSegment: result.KnockoutSegment,
+			GapToPole:       result.GapToLeader,
+			Gap:             formatGap(result),
+		}
+		if result.Position != nil && *result.Position == 1 {
+			// El tiempo de la pole es el de la última tanda que disputó el poleman
+			for _, segmentTime := range []*float64{result.Q3Time, result.Q2Time, result.Q1Time} {
+				if segmentTime != nil {
+					sheet.PoleTime = segmentTime
+					break
+				}
+			}
+		}
+		sheet.Entries = append(sheet.Entries, entry)
+	}
+	return sheet, nil
+}
@@ -4,12 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
 	"strings"
+	"time"
 
 	e "prediapp.local/results/pkg/utils"
 
 	"prediapp.local/db/model"
+	"prediapp.local/racedata"
 	"prediapp.local/results/internal/client"
 	"prediapp.local/results/internal/dto"
 	"prediapp.local/results/internal/repository"
@@ -18,11 +19,15 @@ import (
 )
 
 type resultService struct {
-	resultRepo     repository.ResultRepository
-	driversClient  *client.HttpClient
-	sessionsClient *client.HttpClient
-	usersClient    *client.HttpClient
-	externalClient *client.HttpClient
+	resultRepo       repository.ResultRepository
+	driversClient    *client.HttpClient
+	sessionsClient   *client.HttpClient
+	usersClient      *client.HttpClient
+	prodesClient     *client.HttpClient
+	raceData         racedata.RaceDataProvider
+	driverCache      *e.Cache   // info de drivers por driver_number
+	live             *liveStore // posiciones provisorias de las sesiones en curso
+	ingestionWorkers int        // pilotos que se consultan en paralelo durante una ingesta (1 = en serie)
 	// cache          *e.Cache
 }
 
@@ -34,10 +39,52 @@ type ResultService interface {
 	GetFastestLapInSession(ctx context.Context, sessionID int) (dto.ResponseResultDTO, e.ApiError)
 	CreateResult(ctx context.Context, request dto.CreateResultDTO) (dto.ResponseResultDTO, e.ApiError)
 	DeleteResult(ctx context.Context, resultID int) e.ApiError
-	GetAllResults(ctx context.Context) ([]dto.ResponseResultDTO, e.ApiError)
+	GetAllResults(ctx context.Context, offset, limit int) ([]dto.ResponseResultDTO, e.ApiError)
 	GetTopNDriversInSession(ctx context.Context, sessionID int, n int) ([]dto.TopDriverDTO, e.ApiError)
 	DeleteAllResultsForSession(ctx context.Context, sessionID int) e.ApiError
 	CreateSessionResultsAdmin(ctx context.Context, bulkRequest dto.CreateBulkResultsDTO) ([]dto.ResponseResultDTO, e.ApiError)
+	PreviewSessionResultsAdmin(ctx context.Context, bulkRequest dto.CreateBulkResultsDTO) (dto.BulkResultsDiffDTO, e.ApiError)
+	ImportSessionResults(ctx context.Context, sessionID int, filename string, content []byte, commit bool) (dto.ImportReportDTO, e.ApiError)
+	ResolveSessionProps(ctx context.Context, sessionID int) ([]dto.PropResultDTO, e.ApiError)
+	GetSessionPropResults(ctx context.Context, sessionID int) ([]dto.PropResultDTO, e.ApiError)
+	SetSessionPropAnswer(ctx context.Context, sessionID int, propID int, answer string) (dto.PropResultDTO, e.ApiError)
+
+	// Ciclo de vida: provisional -> official -> amended
+	GetSessionResultStatus(ctx context.Context, sessionID int) (dto.SessionResultStatusDTO, e.ApiError)
+	MarkSessionOfficial(ctx context.Context, sessionID int) (dto.SessionResultStatusDTO, e.ApiError)
+	AmendResult(ctx context.Context, resultID int, request dto.AmendResultDTO) (dto.ResultAmendmentResponseDTO, e.ApiError)
+	AmendSessionResults(ctx context.Context, sessionID int, request dto.AmendSessionResultsDTO) (dto.SessionAmendmentResponseDTO, e.ApiError)
+	GetSessionAmendments(ctx context.Context, sessionID int) ([]dto.ResultAmendmentDTO, e.ApiError)
+
+	// Vueltas
+	GetSessionLaps(ctx context.Context, sessionID int) (dto.SessionLapsDTO, e.ApiError)
+	GetDriverLapChart(ctx context.Context, sessionID, driverID int) (dto.DriverLapChartDTO, e.ApiError)
+
+	// Paradas en boxes
+	GetSessionPitStops(ctx context.Context, sessionID int) (dto.SessionPitStopsDTO, e.ApiError)
+
+	// Clasificación por tandas
+	GetQualifyingSheet(ctx context.Context, sessionID int) (dto.QualifyingSheetDTO, e.ApiError)
+
+	// Estadísticas agregadas
+	GetResultStats(ctx context.Context, query dto.ResultStatsQueryDTO) (dto.ResultStatsDTO, e.ApiError)
+
+	// Posiciones en vivo (provisorias)
+	GetLiveStandings(ctx context.Context, sessionID int) (dto.LiveStandingsDTO, e.ApiError)
+	RefreshLiveStandings(ctx context.Context) e.ApiError
+	StartLiveJob(ctx context.Context, interval time.Duration)
+
+	// Comparación entre compañeros de equipo
+	GetHeadToHead(ctx context.Context, driverID, year int) (dto.HeadToHeadDTO, e.ApiError)
+
+	// Backfill histórico
+	BackfillSeason(ctx context.Context, year int, force bool) (dto.BackfillReportDTO, e.ApiError)
+
+	// Puntos del campeonato y posiciones
+	GetDriverStandings(ctx context.Context, year int) (dto.DriverStandingsDTO, e.ApiError)
+	GetConstructorStandings(ctx context.Context, year int) (dto.ConstructorStandingsDTO, e.ApiError)
+	GetPointsSystems(ctx context.Context) ([]dto.PointsSystemDTO, e.ApiError)
+	SavePointsSystem(ctx context.Context, request dto.PointsSystemDTO) (dto.PointsSystemSavedDTO, e.ApiError)
 }
 
 func NewResultService(
@@ -45,21 +92,31 @@ func NewResultService(
 	driversClient *client.HttpClient,
 	sessionsClient *client.HttpClient,
 	usersClient *client.HttpClient,
-	externalClient *client.HttpClient,
+	prodesClient *client.HttpClient,
+	raceData racedata.RaceDataProvider,
 	// cache *e.Cache,
 ) ResultService {
 	return &resultService{
-		resultRepo:     resultRepo,
-		driversClient:  driversClient,
-		sessionsClient: sessionsClient,
-		usersClient:    usersClient,
-		externalClient: externalClient,
+		resultRepo:       resultRepo,
+		driversClient:    driversClient,
+		sessionsClient:   sessionsClient,
+		usersClient:      usersClient,
+		prodesClient:     prodesClient,
+		raceData:         raceData,
+		driverCache:      e.NewCache(driverCacheTTL, 100),
+		live:             newLiveStore(),
+		ingestionWorkers: defaultIngestionWorkers,
 		// cache:          cache,
 	}
 }
 
 // FetchResultsFromExternalAPI obtiene los resultados de una API externa y los inserta o actualiza en la base de datos
 func (s *resultService) FetchResultsFromExternalAPI(ctx context.Context, sessionID int) ([]dto.ResponseResultDTO, e.ApiError) {
+	// Los resultados oficiales no se pisan con una nueva ingesta
+	if apiErr := s.checkResultsEditable(ctx, sessionID); apiErr != nil {
+		return nil, apiErr
+	}
+
 	// 1. Obtener sessionKey llamando al otro microservicio
 	sessionKey, err := s.sessionsClient.GetSessionKeyBySessionID(sessionID)
 	if err != nil {
@@ -67,32 +124,22 @@ func (s *resultService) FetchResultsFromExternalAPI(ctx context.Context, session
 	}
 	fmt.Println("Session Key obtenida:", sessionKey)
 
-	// 2. Obtener las "positions" desde la API externa
-	positions, err := s.externalClient.GetPositions(sessionKey)
+	// 2. Obtener la info de la sesión una sola vez para armar los DTOs de respuesta
+	sessionData, err := s.sessionsClient.GetSessionByID(sessionID)
 	if err != nil {
-		return nil, e.NewInternalServerApiError("Error fetching positions from external API", err)
-	}
-
-	// Mapa para quedarnos con la última posición reportada (driverNumber -> *int)
-	finalPositions := make(map[int]*int)
-
-	// Agrupar posiciones por driverNumber y ordenar por date para obtener la última posición
-	positionsByDriver := make(map[int][]dto.Position)
-	for _, pos := range positions {
-		positionsByDriver[pos.DriverNumber] = append(positionsByDriver[pos.DriverNumber], pos)
+		return nil, e.NewInternalServerApiError("Error fetching session data", err)
 	}
 
-	// Ordenar posiciones por date y tomar la última para cada piloto
-	for driverNumber, driverPositions := range positionsByDriver {
-		sort.Slice(driverPositions, func(i, j int) bool {
-			return driverPositions[i].Date < driverPositions[j].Date
-		})
-		if len(driverPositions) > 0 {
-			finalPositions[driverNumber] = driverPositions[len(driverPositions)-1].Position
-		}
+	// 3. Obtener las "positions" desde la API externa: la última de cada piloto es la final y la primera la de largada
+	positions, err := s.raceData.GetPositions(sessionKey)
+	if err != nil {
+		return nil, e.NewInternalServerApiError("Error fetching positions from external API", err)
 	}
+	finalPositions := finalPositionsByDriver(positions)
+	gridPositions := gridPositionsByDriver(positions)
+	driverNumbers := sortedDriverNumbers(finalPositions)
 
-	// 3. Encontrar al piloto en posición 1 para determinar el número total de vueltas
+	// 4. Encontrar al piloto en posición 1 para determinar el número total de vueltas
 	var position1DriverNumber int
 	for driverNumber, position := range finalPositions {
 		if position != nil && *position == 1 {
@@ -106,112 +153,62 @@ func (s *resultService) FetchResultsFromExternalAPI(ctx context.Context, session
 		return nil, e.NewInternalServerApiError("No se encontró un piloto en posición 1 para determinar las vueltas totales", nil)
 	}
 
-	// Obtener las vueltas del piloto en posición 1
-	position1Laps, err := s.externalClient.GetLaps(sessionKey, position1DriverNumber)
+	// 5. Obtener las vueltas de todos los pilotos en una sola llamada y resumirlas
+	lapsByDriver, err := s.getLapsByDriver(sessionKey)
 	if err != nil {
-		return nil, e.NewInternalServerApiError(fmt.Sprintf("Error obteniendo vueltas del piloto en posición 1 (driver %d): %v", position1DriverNumber, err), err)
+		return nil, e.NewInternalServerApiError("Error obteniendo vueltas de la sesión", err)
 	}
+	summaries, raceStart := summarizeLaps(lapsByDriver)
 
-	totalSessionLaps := len(position1Laps)
-
-	var responseResults []dto.ResponseResultDTO
-
-	// 4. Para cada driverNumber, determinamos la vuelta más rápida y actualizamos/insertamos en DB
-	for driverNumber, positionPtr := range finalPositions {
-		laps, err := s.externalClient.GetLaps(sessionKey, driverNumber)
-		if err != nil {
-			fmt.Printf("Error obteniendo vueltas para driver %d: %v\n", driverNumber, err)
-			continue
-		}
-
-		// 5. Encontrar la vuelta más rápida o asignar 0 si no hay vueltas
-		var fastestLap float64
-		if len(laps) == 0 {
-			fastestLap = 0
-		} else {
-			for _, lap := range laps {
-				if fastestLap == 0 || lap.LapDuration < fastestLap {
-					fastestLap = lap.LapDuration
-				}
-			}
-		}
+	// 6. Obtener los últimos gaps informados. Si la API no los tiene se calculan con los tiempos de llegada
+	intervals, err := s.raceData.GetIntervals(sessionKey, 0)
+	if err != nil {
+		fmt.Printf("Error obteniendo intervals de la sesión %d, se calculan desde las vueltas: %v\n", sessionID, err)
+	}
+	gaps := lastGapsByDriver(intervals)
 
-		// 6. Determinar el status basado en las vueltas
-		var status string
-		if len(laps) == 0 {
-			status = "DNS" // Did Not Start
-		} else if len(laps) < totalSessionLaps {
-			status = "DNF" // Did Not Finish
-		} else {
-			status = "FINISHED" // Completó todas las vueltas
-		}
+	// 7. Obtener info completa de los drivers desde el microservicio de drivers (en paralelo y con caché)
+	drivers := s.getDriversByNumber(driverNumbers)
 
-		// 7. Obtener info completa del driver desde microservicio de drivers
-		driverInfo, err := s.driversClient.GetDriverByNumber(driverNumber)
-		if err != nil {
-			fmt.Printf("Error obteniendo info del driver_number %d: %v\n", driverNumber, err)
+	// 8. Armar los resultados y clasificarlos: status, vueltas, tiempo total, gaps y grilla. Una clasificación
+	//    inconsistente (posiciones repetidas o con huecos) no se guarda
+	var results []*model.Result
+	var resultDrivers []dto.ResponseDriverDTO
+	var resultDriverNumbers []int
+	for _, driverNumber := range driverNumbers {
+		driverInfo, ok := drivers[driverNumber]
+		if !ok {
 			continue
 		}
+		results = append(results, &model.Result{
+			SessionID: sessionID,
+			DriverID:  driverInfo.ID,
+			Position:  finalPositions[driverNumber],
+		})
+		resultDrivers = append(resultDrivers, driverInfo)
+		resultDriverNumbers = append(resultDriverNumbers, driverNumber)
+	}
+	classifyRaceResults(results, resultDriverNumbers, position1DriverNumber, summaries, raceStart, gaps, gridPositions)
+	if violations := validateClassification(classificationEntries(results), s.providerEntrants(sessionKey)); len(violations) > 0 {
+		return nil, classificationError(violations)
+	}
 
-		// 8. Ver si ya existe un resultado para (driver, session)
-		existingResult, _ := s.resultRepo.GetResultByDriverAndSession(ctx, driverInfo.ID, sessionID)
-
-		if existingResult == nil {
-			// Crear nuevo result
-			newResult := &model.Result{
-				SessionID:      sessionID,
-				DriverID:       driverInfo.ID,
-				Position:       positionPtr,
-				Status:         status,
-				FastestLapTime: fastestLap,
-			}
-			if err := s.resultRepo.CreateResult(ctx, newResult); err != nil {
-				return nil, e.NewInternalServerApiError("Error inserting result", err)
-			}
-			existingResult = newResult
-		} else {
-			// Actualizar
-			existingResult.Position = positionPtr
-			existingResult.Status = status
-			existingResult.FastestLapTime = fastestLap
-			if err := s.resultRepo.UpdateResult(ctx, existingResult); err != nil {
-				return nil, e.NewInternalServerApiError("Error updating existing result", err)
-			}
-		}
-
-		// 9. Obtener la info de la sesión para armar el DTO de respuesta
-		sessionData, err := s.sessionsClient.GetSessionByID(sessionID)
-		if err != nil {
-			return nil, e.NewInternalServerApiError("Error fetching session data", err)
-		}
+	// 9. Insertar o actualizar todos los resultados en una sola transacción, quedan como provisionales
+	if apiErr := s.resultRepo.UpsertSessionResults(ctx, sessionID, results); apiErr != nil {
+		return nil, apiErr
+	}
+	if apiErr := s.resultRepo.EnsureProvisionalStatus(ctx, sessionID); apiErr != nil {
+		return nil, apiErr
+	}
+	if apiErr := s.saveSessionTiming(ctx, sessionID, sessionKey, lapsByDriver, drivers); apiErr != nil {
+		return nil, apiErr
+	}
+	s.refreshSessionPoints(ctx, sessionID, results...)
 
-		// 10. Construir el DTO
-		responseResult := dto.ResponseResultDTO{
-			ID:             existingResult.ID,
-			Position:       existingResult.Position,
-			Status:         existingResult.Status,
-			FastestLapTime: existingResult.FastestLapTime,
-			Driver: dto.ResponseDriverDTO{
-				ID:          driverInfo.ID,
-				FirstName:   driverInfo.FirstName,
-				LastName:    driverInfo.LastName,
-				FullName:    driverInfo.FullName,
-				NameAcronym: driverInfo.NameAcronym,
-				TeamName:    driverInfo.TeamName,
-			},
-			Session: dto.ResponseSessionDTO{
-				ID:               sessionData.ID,
-				CircuitShortName: sessionData.CircuitShortName,
-				CountryName:      sessionData.CountryName,
-				Location:         sessionData.Location,
-				SessionName:      sessionData.SessionName,
-				SessionType:      sessionData.SessionType,
-				DateStart:        sessionData.DateStart,
-			},
-			CreatedAt: existingResult.CreatedAt,
-			UpdatedAt: existingResult.UpdatedAt,
-		}
-		responseResults = append(responseResults, responseResult)
+	// 10. Construir los DTOs
+	responseResults := make([]dto.ResponseResultDTO, 0, len(results))
+	for i, result := range results {
+		responseResults = append(responseResults, buildIngestedResultDTO(result, resultDrivers[i], sessionData))
 	}
 
 	return responseResults, nil
@@ -229,6 +226,11 @@ func (s *resultService) FetchNonRaceSessionResults(ctx context.Context, sessionI
 		return nil, e.NewBadRequestApiError("Este endpoint solo soporta sesiones no Race")
 	}
 
+	// Los resultados oficiales no se pisan con una nueva ingesta
+	if apiErr := s.checkResultsEditable(ctx, sessionId); apiErr != nil {
+		return nil, apiErr
+	}
+
 	// 2. Obtener sessionKey llamando al microservicio de sessions
 	sessionKey, err := s.sessionsClient.GetSessionKeyBySessionID(sessionId)
 	if err != nil {
@@ -236,96 +238,75 @@ func (s *resultService) FetchNonRaceSessionResults(ctx context.Context, sessionI
 	}
 
 	// 3. Obtener las posiciones desde la API externa
-	positions, err := s.externalClient.GetPositions(sessionKey)
+	positions, err := s.raceData.GetPositions(sessionKey)
 	if err != nil {
 		return nil, e.NewInternalServerApiError("Error fetching positions from external API", err)
 	}
 
-	// Mapa para quedarnos con la última posición reportada (driverNumber -> *int)
-	finalPositions := make(map[int]*int)
+	finalPositions := finalPositionsByDriver(positions)
+	driverNumbers := sortedDriverNumbers(finalPositions)
+
+	// 4. Obtener info completa de los drivers (en paralelo y con caché)
+	drivers := s.getDriversByNumber(driverNumbers)
 
-	// Agrupar posiciones por driverNumber y ordenar por date para obtener la última posición
-	positionsByDriver := make(map[int][]dto.Position)
-	for _, pos := range positions {
-		positionsByDriver[pos.DriverNumber] = append(positionsByDriver[pos.DriverNumber], pos)
+	// Las vueltas no definen la posición en sesiones no Race, pero dan la vuelta más rápida y las tandas de la qualy
+	lapsByDriver, err := s.getLapsByDriver(sessionKey)
+	if err != nil {
+		return nil, e.NewInternalServerApiError("Error obteniendo vueltas de la sesión", err)
 	}
+	summaries, _ := summarizeLaps(lapsByDriver)
 
-	// Ordenar posiciones por date y tomar la última para cada piloto
-	for driverNumber, driverPositions := range positionsByDriver {
-		sort.Slice(driverPositions, func(i, j int) bool {
-			return driverPositions[i].Date < driverPositions[j].Date
-		})
-		if len(driverPositions) > 0 {
-			finalPositions[driverNumber] = driverPositions[len(driverPositions)-1].Position
+	// 5. Armar los resultados de cada piloto. Status por defecto para sesiones no Race
+	var results []*model.Result
+	var resultDrivers []dto.ResponseDriverDTO
+	var resultDriverNumbers []int
+	for _, driverNumber := range driverNumbers {
+		driverInfo, ok := drivers[driverNumber]
+		if !ok {
+			continue
 		}
+		results = append(results, &model.Result{
+			SessionID:      sessionId,
+			DriverID:       driverInfo.ID,
+			Position:       finalPositions[driverNumber],
+			Status:         "FINISHED",
+			FastestLapTime: summaries[driverNumber].FastestLap,
+			LapsCompleted:  summaries[driverNumber].LapsCompleted,
+		})
+		resultDrivers = append(resultDrivers, driverInfo)
+		resultDriverNumbers = append(resultDriverNumbers, driverNumber)
 	}
 
-	var responseResults []dto.ResponseResultDTO
-
-	// 4. Procesar cada piloto y sus posiciones
-	for driverNumber, positionPtr := range finalPositions {
-		// Obtener info completa del driver desde microservicio de drivers
-		driverInfo, err := s.driversClient.GetDriverByNumber(driverNumber)
+	// En la clasificación se reparten las vueltas en Q1, Q2 y Q3. Sin los mensajes de dirección de carrera
+	// las tandas se deducen de los cortes entre vueltas
+	if isQualifyingSession(sessionData.SessionName, sessionData.SessionType) {
+		events, err := s.raceData.GetRaceControl(sessionKey)
 		if err != nil {
-			fmt.Printf("Error obteniendo info del driver_number %d: %v\n", driverNumber, err)
-			continue
+			fmt.Printf("Error obteniendo race control de la sesión %d, las tandas se deducen de las vueltas: %v\n", sessionId, err)
 		}
+		segmentStarts := qualifyingSegmentStarts(events, lapsByDriver)
+		classifyQualifyingResults(results, resultDriverNumbers, summarizeQualifying(lapsByDriver, segmentStarts))
+	}
+	if violations := validateClassification(classificationEntries(results), s.providerEntrants(sessionKey)); len(violations) > 0 {
+		return nil, classificationError(violations)
+	}
 
-		// Verificar si ya existe un resultado para (driver, session)
-		existingResult, _ := s.resultRepo.GetResultByDriverAndSession(ctx, driverInfo.ID, sessionId)
-
-		// Status por defecto para sesiones no Race
-		status := "FINISHED"
-
-		if existingResult == nil {
-			newResult := &model.Result{
-				SessionID:      sessionId,
-				DriverID:       driverInfo.ID,
-				Position:       positionPtr,
-				Status:         status,
-				FastestLapTime: 0, // No se consideran vueltas
-			}
-			if err := s.resultRepo.CreateResult(ctx, newResult); err != nil {
-				return nil, e.NewInternalServerApiError("Error inserting result", err)
-			}
-			existingResult = newResult
-		} else {
-			// Actualizar
-			existingResult.Position = positionPtr
-			existingResult.Status = status
-			existingResult.FastestLapTime = 0 // No se consideran vueltas
-			if err := s.resultRepo.UpdateResult(ctx, existingResult); err != nil {
-				return nil, e.NewInternalServerApiError("Error updating existing result", err)
-			}
-		}
+	// 6. Insertar o actualizar todos los resultados en una sola transacción, quedan como provisionales
+	if apiErr := s.resultRepo.UpsertSessionResults(ctx, sessionId, results); apiErr != nil {
+		return nil, apiErr
+	}
+	if apiErr := s.resultRepo.EnsureProvisionalStatus(ctx, sessionId); apiErr != nil {
+		return nil, apiErr
+	}
+	if apiErr := s.saveSessionTiming(ctx, sessionId, sessionKey, lapsByDriver, drivers); apiErr != nil {
+		return nil, apiErr
+	}
+	s.refreshSessionPoints(ctx, sessionId, results...)
 
-		// Construir el DTO
-		responseResult := dto.ResponseResultDTO{
-			ID:             existingResult.ID,
-			Position:       existingResult.Position,
-			Status:         existingResult.Status,
-			FastestLapTime: existingResult.FastestLapTime,
-			Driver: dto.ResponseDriverDTO{
-				ID:          driverInfo.ID,
-				FirstName:   driverInfo.FirstName,
-				LastName:    driverInfo.LastName,
-				FullName:    driverInfo.FullName,
-				NameAcronym: driverInfo.NameAcronym,
-				TeamName:    driverInfo.TeamName,
-			},
-			Session: dto.ResponseSessionDTO{
-				ID:               sessionData.ID,
-				CircuitShortName: sessionData.CircuitShortName,
-				CountryName:      sessionData.CountryName,
-				Location:         sessionData.Location,
-				SessionName:      sessionData.SessionName,
-				SessionType:      sessionData.SessionType,
-				DateStart:        sessionData.DateStart,
-			},
-			CreatedAt: existingResult.CreatedAt,
-			UpdatedAt: existingResult.UpdatedAt,
-		}
-		responseResults = append(responseResults, responseResult)
+	// 7. Construir los DTOs
+	responseResults := make([]dto.ResponseResultDTO, 0, len(results))
+	for i, result := range results {
+		responseResults = append(responseResults, buildIngestedResultDTO(result, resultDrivers[i], sessionData))
 	}
 
 	return responseResults, nil
@@ -373,7 +354,10 @@ func (s *resultService) CreateResult(ctx context.Context, request dto.CreateResu
 		return dto.ResponseResultDTO{}, e.NewBadRequestApiError("Fastest lap time debe ser mayor a 30 (o 0 si se omite)")
 	}
 
-	// 4. Revisar si ya existe un resultado para (driver, session)
+	// 4. Revisar si ya existe un resultado para (driver, session) y que la sesión no sea oficial
+	if apiErr := s.checkResultsEditable(ctx, request.SessionID); apiErr != nil {
+		return dto.ResponseResultDTO{}, apiErr
+	}
 	existingResult, _ := s.resultRepo.GetResultByDriverAndSession(ctx, request.DriverID, request.SessionID)
 	if existingResult != nil {
 		return dto.ResponseResultDTO{}, e.NewBadRequestApiError("Ya existe un resultado para este driver en esta sesión")
@@ -392,17 +376,22 @@ func (s *resultService) CreateResult(ctx context.Context, request dto.CreateResu
 	if err := s.resultRepo.CreateResult(ctx, newResult); err != nil {
 		return dto.ResponseResultDTO{}, e.NewInternalServerApiError("Error creando resultado", err)
 	}
+	if apiErr := s.resultRepo.EnsureProvisionalStatus(ctx, request.SessionID); apiErr != nil {
+		return dto.ResponseResultDTO{}, apiErr
+	}
+	s.refreshSessionPoints(ctx, request.SessionID, newResult)
 
 	// 8. Construir DTO de respuesta
 	response := dto.ResponseResultDTO{
-		ID:             newResult.ID,
-		Position:       newResult.Position,
-		Status:         newResult.Status,
-		FastestLapTime: newResult.FastestLapTime,
-		Driver:         dto.ResponseDriverDTO{ID: newResult.DriverID},
-		Session:        dto.ResponseSessionDTO{ID: newResult.SessionID},
-		CreatedAt:      newResult.CreatedAt,
-		UpdatedAt:      newResult.UpdatedAt,
+		ID:                newResult.ID,
+		Position:          newResult.Position,
+		Status:            newResult.Status,
+		FastestLapTime:    newResult.FastestLapTime,
+		Driver:            dto.ResponseDriverDTO{ID: newResult.DriverID},
+		Session:           dto.ResponseSessionDTO{ID: newResult.SessionID},
+		CreatedAt:         newResult.CreatedAt,
+		UpdatedAt:         newResult.UpdatedAt,
+		ClassificationDTO: classificationFromResult(newResult),
 	}
 
 	return response, nil
@@ -416,49 +405,29 @@ func (s *resultService) UpdateResult(ctx context.Context, resultID int, request
 		return dto.ResponseResultDTO{}, e.NewBadRequestApiError("Error obteniendo el resultado por su ID")
 	}
 
-	// 2. Actualizar STATUS
-	validStatuses := map[string]bool{"FINISHED": true, "DNF": true, "DNS": true, "DSQ": true}
-	if request.Status != "" {
-		// Si viene un nuevo Status, validarlo
-		if !validStatuses[request.Status] {
-			return dto.ResponseResultDTO{}, e.NewBadRequestApiError(fmt.Sprintf("Status inválido: %s", request.Status))
-		}
-		result.Status = request.Status
+	// 2. Una vez oficiales, los resultados solo se modifican con una enmienda
+	if apiErr := s.checkResultsEditable(ctx, result.SessionID); apiErr != nil {
+		return dto.ResponseResultDTO{}, apiErr
 	}
 
-	// 3. Actualizar POSITION si viene
-	if request.Position != nil {
-		// Si la nueva position no es nil, forzamos status = FINISHED
-		if result.Status != "" && result.Status != "FINISHED" {
-			return dto.ResponseResultDTO{}, e.NewBadRequestApiError(
-				fmt.Sprintf("No se puede asignar Position si el Status es %s", result.Status),
-			)
-		}
-		if *request.Position < 1 || *request.Position > 20 {
-			return dto.ResponseResultDTO{}, e.NewBadRequestApiError("La posición debe estar entre 1 y 20")
-		}
-		// Marcamos status "FINISHED" si no se había puesto
-		if result.Status == "" || result.Status == "DNF" || result.Status == "DNS" || result.Status == "DSQ" {
-			result.Status = "FINISHED"
-		}
-		result.Position = request.Position
+	// 3. Aplicar status, position y fastest lap
+	if apiErr := applyResultChanges(result, request.Position, request.Status, request.FastestLapTime); apiErr != nil {
+		return dto.ResponseResultDTO{}, apiErr
 	}
 
-	// 4. Actualizar fastestLapTime si != 0
-	if request.FastestLapTime != 0 {
-		if request.FastestLapTime < 30 {
-			return dto.ResponseResultDTO{}, e.NewBadRequestApiError("Invalid fastest lap time, must be > 30")
-		}
-		result.FastestLapTime = request.FastestLapTime
-	}
-
-	// 5. Persistir cambios
+	// 4. Persistir cambios
 	if err := s.resultRepo.UpdateResult(ctx, result); err != nil {
 		return dto.ResponseResultDTO{}, e.NewInternalServerApiError("Error updating result", err)
 	}
+	s.refreshSessionPoints(ctx, result.SessionID, result)
 
-	// 7. Construir respuesta
-	response := dto.ResponseResultDTO{
+	// 5. Construir respuesta
+	return toResponseResultDTO(result), nil
+}
+
+// toResponseResultDTO arma la respuesta de un resultado con el Driver y la Session precargados
+func toResponseResultDTO(result *model.Result) dto.ResponseResultDTO {
+	return dto.ResponseResultDTO{
 		ID:             result.ID,
 		Position:       result.Position,
 		Status:         result.Status,
@@ -480,11 +449,51 @@ func (s *resultService) UpdateResult(ctx context.Context, resultID int, request
 			SessionType:      result.Session.SessionType,
 			DateStart:        result.Session.DateStart,
 		},
-		CreatedAt: result.CreatedAt,
-		UpdatedAt: result.UpdatedAt,
+		CreatedAt:         result.CreatedAt,
+		UpdatedAt:         result.UpdatedAt,
+		ClassificationDTO: classificationFromResult(result),
+	}
+}
+
+// applyResultChanges valida y aplica sobre el resultado los campos que vienen informados
+func applyResultChanges(result *model.Result, position *int, status string, fastestLapTime float64) e.ApiError {
+	// Actualizar STATUS
+	validStatuses := map[string]bool{"FINISHED": true, "DNF": true, "DNS": true, "DSQ": true}
+	if status != "" {
+		// Si viene un nuevo Status, validarlo
+		if !validStatuses[status] {
+			return e.NewBadRequestApiError(fmt.Sprintf("Status inválido: %s", status))
+		}
+		result.Status = status
 	}
 
-	return response, nil
+	// Actualizar POSITION si viene
+	if position != nil {
+		// Si la nueva position no es nil, forzamos status = FINISHED
+		if result.Status != "" && result.Status != "FINISHED" {
+			return e.NewBadRequestApiError(
+				fmt.Sprintf("No se puede asignar Position si el Status es %s", result.Status),
+			)
+		}
+		if *position < 1 || *position > 20 {
+			return e.NewBadRequestApiError("La posición debe estar entre 1 y 20")
+		}
+		// Marcamos status "FINISHED" si no se había puesto
+		if result.Status == "" || result.Status == "DNF" || result.Status == "DNS" || result.Status == "DSQ" {
+			result.Status = "FINISHED"
+		}
+		result.Position = position
+	}
+
+	// Actualizar fastestLapTime si != 0
+	if fastestLapTime != 0 {
+		if fastestLapTime < 30 {
+			return e.NewBadRequestApiError("Invalid fastest lap time, must be > 30")
+		}
+		result.FastestLapTime = fastestLapTime
+	}
+
+	return nil
 }
 
 // GetResultsOrderedByPosition obtiene los resultados de una sesión específica ordenados por posición
@@ -543,8 +552,9 @@ func (s *resultService) GetResultsOrderedByPosition(ctx context.Context, session
 				SessionType:      result.Session.SessionType,
 				DateStart:        result.Session.DateStart,
 			},
-			CreatedAt: result.CreatedAt,
-			UpdatedAt: result.UpdatedAt,
+			CreatedAt:         result.CreatedAt,
+			UpdatedAt:         result.UpdatedAt,
+			ClassificationDTO: classificationFromResult(result),
 		}
 		responseResults = append(responseResults, response)
 	}
@@ -621,8 +631,9 @@ func (s *resultService) GetFastestLapInSession(ctx context.Context, sessionID in
 			SessionType:      fastestResult.Session.SessionType,
 			DateStart:        fastestResult.Session.DateStart,
 		},
-		CreatedAt: fastestResult.CreatedAt,
-		UpdatedAt: fastestResult.UpdatedAt,
+		CreatedAt:         fastestResult.CreatedAt,
+		UpdatedAt:         fastestResult.UpdatedAt,
+		ClassificationDTO: classificationFromResult(fastestResult),
 	}
 
 	// Cachear el resultado
@@ -649,12 +660,16 @@ func (s *resultService) DeleteResult(ctx context.Context, resultID int) e.ApiErr
 		}
 		return e.NewInternalServerApiError("Error al verificar la existencia del resultado", err)
 	}
+	if apiErr := s.checkResultsEditable(ctx, result.SessionID); apiErr != nil {
+		return apiErr
+	}
 
 	fmt.Printf("Eliminando resultado: ID=%d, DriverID=%d, SessionID=%d\n", result.ID, result.DriverID, result.SessionID)
 
 	if err := s.resultRepo.DeleteResult(ctx, resultID); err != nil {
 		return e.NewInternalServerApiError("Error al eliminar el resultado", err)
 	}
+	s.refreshSessionPoints(ctx, result.SessionID)
 
 	// // Invalidar caché relevante
 	// cacheKeys := []string{
@@ -676,6 +691,9 @@ func (s *resultService) DeleteAllResultsForSession(ctx context.Context, sessionI
 	if sessionID == 0 {
 		return e.NewBadRequestApiError("El ID de la sesión no puede ser 0")
 	}
+	if apiErr := s.checkResultsEditable(ctx, sessionID); apiErr != nil {
+		return apiErr
+	}
 
 	results, err := s.resultRepo.GetResultsBySessionID(ctx, sessionID)
 	if err != nil {
@@ -691,6 +709,7 @@ func (s *resultService) DeleteAllResultsForSession(ctx context.Context, sessionI
 			return e.NewInternalServerApiError(fmt.Sprintf("Error al eliminar el resultado con ID %d", result.ID), err)
 		}
 	}
+	s.refreshSessionPoints(ctx, sessionID)
 
 	// // Invalidar caché relevante
 	// cacheKeys := []string{
@@ -707,8 +726,8 @@ func (s *resultService) DeleteAllResultsForSession(ctx context.Context, sessionI
 	return nil
 }
 
-// GetAllResults obtiene todos los resultados de la base de datos
-func (s *resultService) GetAllResults(ctx context.Context) ([]dto.ResponseResultDTO, e.ApiError) {
+// GetAllResults obtiene una página de resultados de la base de datos
+func (s *resultService) GetAllResults(ctx context.Context, offset, limit int) ([]dto.ResponseResultDTO, e.ApiError) {
 	// // Verificar caché
 	// cacheKey := "all_results"
 	// if cached, exists := s.cache.Get(cacheKey); exists {
@@ -718,16 +737,17 @@ func (s *resultService) GetAllResults(ctx context.Context) ([]dto.ResponseResult
 	// 	}
 	// }
 
-	results, err := s.resultRepo.GetAllResults(ctx)
+	results, err := s.resultRepo.GetAllResults(ctx, offset, limit)
 	if err != nil {
 		return nil, e.NewInternalServerApiError("Error al obtener todos los resultados", err)
 	}
 
-	if len(results) == 0 {
+	// Una página vacía después de la primera no es un error: se terminaron los resultados
+	if len(results) == 0 && offset == 0 {
 		return nil, e.NewNotFoundApiError("No se encontraron resultados en la base de datos")
 	}
 
-	var responseResults []dto.ResponseResultDTO
+	responseResults := make([]dto.ResponseResultDTO, 0, len(results))
 	for _, result := range results {
 		response := dto.ResponseResultDTO{
 			ID:             result.ID,
@@ -750,8 +770,9 @@ func (s *resultService) GetAllResults(ctx context.Context) ([]dto.ResponseResult
 				SessionType:      result.Session.SessionType,
 				DateStart:        result.Session.DateStart,
 			},
-			CreatedAt: result.CreatedAt,
-			UpdatedAt: result.UpdatedAt,
+			CreatedAt:         result.CreatedAt,
+			UpdatedAt:         result.UpdatedAt,
+			ClassificationDTO: classificationFromResult(result),
 		}
 		responseResults = append(responseResults, response)
 	}
@@ -827,82 +848,20 @@ func (s *resultService) GetTopNDriversInSession(ctx context.Context, sessionID i
 }
 
 func (s *resultService) CreateSessionResultsAdmin(ctx context.Context, bulkRequest dto.CreateBulkResultsDTO) ([]dto.ResponseResultDTO, e.ApiError) {
-	if bulkRequest.SessionID == 0 {
-		return nil, e.NewBadRequestApiError("El session_id no puede ser 0")
-	}
-
-	var resultsToCreate []*model.Result
-	var resultsToUpdate []*model.Result
-
-	validStatuses := map[string]bool{"FINISHED": true, "DNF": true, "DNS": true, "DSQ": true}
-
-	existingResults, err := s.resultRepo.GetResultsBySessionID(ctx, bulkRequest.SessionID)
-	if err != nil {
-		return nil, e.NewInternalServerApiError("Error obteniendo resultados existentes", err)
-	}
-
-	existingResultsMap := make(map[int]*model.Result)
-	for _, r := range existingResults {
-		existingResultsMap[r.DriverID] = r
-	}
-
-	for _, item := range bulkRequest.Results {
-		if item.Status == "" {
-			if item.Position != nil {
-				item.Status = "FINISHED"
-			} else {
-				item.Status = "DNF"
-			}
-		} else {
-			if !validStatuses[item.Status] {
-				return nil, e.NewBadRequestApiError(fmt.Sprintf("Status inválido: %s", item.Status))
-			}
-		}
-
-		if item.Status == "FINISHED" {
-			if item.Position == nil {
-				return nil, e.NewBadRequestApiError("Debe proporcionar una posición si el status es FINISHED")
-			}
-			if *item.Position < 1 || *item.Position > 20 {
-				return nil, e.NewBadRequestApiError(
-					fmt.Sprintf("Posición inválida para driver_id %d. Debe estar entre 1 y 20", item.DriverID),
-				)
-			}
-		} else {
-			if item.Position != nil {
-				return nil, e.NewBadRequestApiError(
-					fmt.Sprintf("No puede dar Position si el status es %s (driver_id %d)", item.Status, item.DriverID),
-				)
-			}
-		}
-
-		if item.FastestLapTime != 0 && item.FastestLapTime < 30 {
-			return nil, e.NewBadRequestApiError(
-				fmt.Sprintf("FastestLapTime inválido para driver_id %d. Debe ser >30 o 0", item.DriverID),
-			)
-		}
-
-		if existingResult, exists := existingResultsMap[item.DriverID]; exists {
-			existingResult.Position = item.Position
-			existingResult.Status = item.Status
-			existingResult.FastestLapTime = item.FastestLapTime
-			resultsToUpdate = append(resultsToUpdate, existingResult)
-		} else {
-			newResult := &model.Result{
-				SessionID:      bulkRequest.SessionID,
-				DriverID:       item.DriverID,
-				Position:       item.Position,
-				Status:         item.Status,
-				FastestLapTime: item.FastestLapTime,
-			}
-			resultsToCreate = append(resultsToCreate, newResult)
-		}
+	plan, apiErr := s.planSessionResults(ctx, bulkRequest)
+	if apiErr != nil {
+		return nil, apiErr
 	}
+	resultsToCreate, resultsToUpdate := plan.toCreate, plan.toUpdate
 
 	txErr := s.resultRepo.SessionCreateOrUpdateResultsAdmin(ctx, resultsToCreate, resultsToUpdate)
 	if txErr != nil {
 		return nil, e.NewInternalServerApiError("Error creando o actualizando resultados masivamente", txErr)
 	}
+	if apiErr := s.resultRepo.EnsureProvisionalStatus(ctx, bulkRequest.SessionID); apiErr != nil {
+		return nil, apiErr
+	}
+	s.refreshSessionPoints(ctx, bulkRequest.SessionID)
 
 	updatedResults, err := s.resultRepo.GetResultsBySessionID(ctx, bulkRequest.SessionID)
 	if err != nil {
@@ -924,16 +883,58 @@ func (s *resultService) CreateSessionResultsAdmin(ctx context.Context, bulkReque
 	var responseResults []dto.ResponseResultDTO
 	for _, r := range updatedResults {
 		responseResults = append(responseResults, dto.ResponseResultDTO{
-			ID:             r.ID,
-			Position:       r.Position,
-			Status:         r.Status,
-			FastestLapTime: r.FastestLapTime,
-			Driver:         dto.ResponseDriverDTO{ID: r.DriverID},
-			Session:        dto.ResponseSessionDTO{ID: r.SessionID},
-			CreatedAt:      r.CreatedAt,
-			UpdatedAt:      r.UpdatedAt,
+			ID:                r.ID,
+			Position:          r.Position,
+			Status:            r.Status,
+			FastestLapTime:    r.FastestLapTime,
+			Driver:            dto.ResponseDriverDTO{ID: r.DriverID},
+			Session:           dto.ResponseSessionDTO{ID: r.SessionID},
+			CreatedAt:         r.CreatedAt,
+			UpdatedAt:         r.UpdatedAt,
+			ClassificationDTO: classificationFromResult(r),
 		})
 	}
 
 	return responseResults, nil
 }
+
+// normalizeResultItem completa el status por defecto de un ítem de carga masiva y valida status, posición y vuelta rápida
+func normalizeResultItem(item *dto.CreateResultItemDTO) e.ApiError {
+	validStatuses := map[string]bool{"FINISHED": true, "DNF": true, "DNS": true, "DSQ": true}
+
+	if item.Status == "" {
+		if item.Position != nil {
+			item.Status = "FINISHED"
+		} else {
+			item.Status = "DNF"
+		}
+	} else {
+		if !validStatuses[item.Status] {
+			return e.NewBadRequestApiError(fmt.Sprintf("Status inválido: %s", item.Status))
+		}
+	}
+
+	if item.Status == "FINISHED" {
+		if item.Position == nil {
+			return e.NewBadRequestApiError("Debe proporcionar una posición si el status es FINISHED")
+		}
+		if *item.Position < 1 || *item.Position > 20 {
+			return e.NewBadRequestApiError(
+				fmt.Sprintf("Posición inválida para driver_id %d. Debe estar entre 1 y 20", item.DriverID),
+			)
+		}
+	} else {
+		if item.Position != nil {
+			return e.NewBadRequestApiError(
+				fmt.Sprintf("No puede dar Position si el status es %s (driver_id %d)", item.Status, item.DriverID),
+			)
+		}
+	}
+
+	if item.FastestLapTime != 0 && item.FastestLapTime < 30 {
+		return e.NewBadRequestApiError(
+			fmt.Sprintf("FastestLapTime inválido para driver_id %d. Debe ser >30 o 0", item.DriverID),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"prediapp.local/db/model"
+	"prediapp.local/results/internal/dto"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// GetDriverStandings devuelve el campeonato de pilotos de la temporada
+func (s *resultService) GetDriverStandings(ctx context.Context, year int) (dto.DriverStandingsDTO, e.ApiError) {
+	rows, apiErr := s.resultRepo.GetDriverStandings(ctx, year)
+	if apiErr != nil {
+		return dto.DriverStandingsDTO{}, apiErr
+	}
+
+	// Desempate: más victorias, después más podios
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Points != rows[j].Points {
+			return rows[i].Points > rows[j].Points
+		}
+		if rows[i].Wins != rows[j].Wins {
+			return rows[i].Wins > rows[j].Wins
+		}
+		if rows[i].Podiums != rows[j].Podiums {
+			return rows[i].Podiums > rows[j].Podiums
+		}
+		return rows[i].FullName < rows[j].FullName
+	})
+
+	response := dto.DriverStandingsDTO{Year: year, Standings: make([]dto.DriverStandingDTO, 0, len(rows))}
+	for i, row := range rows {
+		response.Standings = append(response.Standings, dto.DriverStandingDTO{
+			Position:    i + 1,
+			DriverID:    row.DriverID,
+			FullName:    row.FullName,
+			NameAcronym: row.NameAcronym,
+			TeamName:    row.TeamName,
+			Points:      row.Points,
+			Wins:        row.Wins,
+			Podiums:     row.Podiums,
+			Races:       row.Races,
+		})
+	}
+	return response, nil
+}
+
+// GetConstructorStandings devuelve el campeonato de constructores de la temporada
+func (s *resultService) GetConstructorStandings(ctx context.Context, year int) (dto.ConstructorStandingsDTO, e.ApiError) {
+	rows, apiErr := s.resultRepo.GetConstructorStandings(ctx, year)
+	if apiErr != nil {
+		return dto.ConstructorStandingsDTO{}, apiErr
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Points != rows[j].Points {
+			return rows[i].Points > rows[j].Points
+		}
+		if rows[i].Wins != rows[j].Wins {
+			return rows[i].Wins > rows[j].Wins
+		}
+		return rows[i].TeamName < rows[j].TeamName
+	})
+
+	response := dto.ConstructorStandingsDTO{Year: year, Standings: make([]dto.ConstructorStandingDTO, 0, len(rows))}
+	for i, row := range rows {
+		response.Standings = append(response.Standings, dto.ConstructorStandingDTO{
+			Position: i + 1,
+			TeamName: row.TeamName,
+			Points:   row.Points,
+			Wins:     row.Wins,
+			Podiums:  row.Podiums,
+		})
+	}
+	return response, nil
+}
+
+// GetPointsSystems devuelve las tablas de puntos configuradas
+func (s *resultService) GetPointsSystems(ctx context.Context) ([]dto.PointsSystemDTO, e.ApiError) {
+	systems, apiErr := s.resultRepo.GetPointsSystems(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	response := make([]dto.PointsSystemDTO, 0, len(systems))
+	for _, system := range systems {
+		points, err := parsePointsTable(system.Points)
+		if err != nil {
+			return nil, e.NewInternalServerApiError(fmt.Sprintf("Tabla de puntos inválida para %s %d", system.SessionKind, system.Year), err)
+		}
+		response = append(response, dto.PointsSystemDTO{
+			Year:                  system.Year,
+			SessionKind:           system.SessionKind,
+			Points:                points,
+			FastestLapPoints:      system.FastestLapPoints,
+			FastestLapMaxPosition: system.FastestLapMaxPosition,
+		})
+	}
+	return response, nil
+}
+
+// SavePointsSystem guarda una tabla de puntos y recalcula los puntos de las sesiones de esa temporada en adelante
+func (s *resultService) SavePointsSystem(ctx context.Context, request dto.PointsSystemDTO) (dto.PointsSystemSavedDTO, e.ApiError) {
+	// 1. Validar
+	for _, points := range request.Points {
+		if points < 0 {
+			return dto.PointsSystemSavedDTO{}, e.NewBadRequestApiError("Los puntos no pueden ser negativos")
+		}
+	}
+	if request.FastestLapPoints < 0 || request.FastestLapMaxPosition < 0 {
+		return dto.PointsSystemSavedDTO{}, e.NewBadRequestApiError("Los valores de vuelta rápida no pueden ser negativos")
+	}
+
+	// 2. Guardar
+	values := make([]string, 0, len(request.Points))
+	for _, points := range request.Points {
+		values = append(values, strconv.FormatFloat(points, 'f', -1, 64))
+	}
+	system := &model.PointsSystem{
+		Year:                  request.Year,
+		SessionKind:           request.SessionKind,
+		Points:                strings.Join(values, ","),
+		FastestLapPoints:      request.FastestLapPoints,
+		FastestLapMaxPosition: request.FastestLapMaxPosition,
+	}
+	if apiErr := s.resultRepo.SavePointsSystem(ctx, system); apiErr != nil {
+		return dto.PointsSystemSavedDTO{}, apiErr
+	}
+
+	// 3. Recalcular las sesiones afectadas
+	sessionIDs, apiErr := s.resultRepo.GetSessionIDsWithResultsSince(ctx, request.Year)
+	if apiErr != nil {
+		return dto.PointsSystemSavedDTO{}, apiErr
+	}
+	for _, sessionID := range sessionIDs {
+		if _, apiErr := s.recalculateSessionPoints(ctx, sessionID); apiErr != nil {
+			return dto.PointsSystemSavedDTO{}, apiErr
+		}
+	}
+
+	return dto.PointsSystemSavedDTO{PointsSystem: request, SessionsRecomputed: len(sessionIDs)}, nil
+}
+
+// refreshSessionPoints recalcula los puntos después de un cambio en los resultados y los copia a los resultados
+// en memoria recibidos. Un error no revierte el cambio: se loguea y los puntos se corrigen en el próximo recálculo.
+func (s *resultService) refreshSessionPoints(ctx context.Context, sessionID int, changed ...*model.Result) {
+	pointsByResult, apiErr := s.recalculateSessionPoints(ctx, sessionID)
+	if apiErr != nil {
+		fmt.Printf("Error recalculando puntos de la sesión %d: %v\n", sessionID, apiErr)
+		return
+	}
+	for _, result := range changed {
+		result.Points = pointsByResult[result.ID]
+	}
+}
+
+// recalculateSessionPoints asigna los puntos del campeonato a los resultados de la sesión según su tabla de puntos
+func (s *resultService) recalculateSessionPoints(ctx context.Context, sessionID int) (map[int]float64, e.ApiError) {
+	// 1. Resultados de la sesión, con la sesión precargada
+	results, apiErr := s.resultRepo.GetResultsBySessionID(ctx, sessionID)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	pointsByResult := make(map[int]float64)
+	if len(results) == 0 || results[0].Session == nil {
+		return pointsByResult, nil
+	}
+
+	// 2. Tabla de puntos vigente; sesiones que no suman (prácticas, clasificación) quedan en cero
+	if kind := pointsSessionKind(results[0].Session); kind != "" {
+		system, apiErr := s.resultRepo.GetPointsSystem(ctx, results[0].Session.Year, kind)
+		if apiErr != nil && apiErr.Status() != http.StatusNotFound {
+			return nil, apiErr
+		}
+		if system != nil {
+			pointsByResult, apiErr = calculateSessionPoints(results, system)
+			if apiErr != nil {
+				return nil, apiErr
+			}
+		}
+	}
+
+	// 3. Guardar
+	if apiErr := s.resultRepo.UpdateSessionPoints(ctx, sessionID, pointsByResult); apiErr != nil {
+		return nil, apiErr
+	}
+	return pointsByResult, nil
+}
+
+// pointsSessionKind indica qué tabla de puntos aplica a la sesión ("" si no suma puntos)
+func pointsSessionKind(session *model.Session) string {
+	switch {
+	case strings.EqualFold(session.SessionName, "race"):
+		return model.PointsSessionRace
+	case strings.EqualFold(session.SessionName, "sprint"):
+		return model.PointsSessionSprint
+	}
+	return ""
+}
+
+// calculateSessionPoints reparte los puntos por posición a los pilotos que terminaron y suma el punto por vuelta
+// rápida si la tabla lo prevé y su autor terminó dentro de la posición requerida
+func calculateSessionPoints(results []*model.Result, system *model.PointsSystem) (map[int]float64, e.ApiError) {
+	table, err := parsePointsTable(system.Points)
+	if err != nil {
+		return nil, e.NewInternalServerApiError(fmt.Sprintf("Tabla de puntos inválida para %s %d", system.SessionKind, system.Year), err)
+	}
+
+	pointsByResult := make(map[int]float64)
+	var fastest *model.Result
+	for _, result := range results {
+		if result.FastestLapTime > 0 && (fastest == nil || result.FastestLapTime < fastest.FastestLapTime) {
+			fastest = result
+		}
+		if !scoresPoints(result) {
+			continue
+		}
+		if position := *result.Position; position >= 1 && position <= len(table) && table[position-1] > 0 {
+			pointsByResult[result.ID] = table[position-1]
+		}
+	}
+
+	// La vuelta rápida de un piloto que abandonó o terminó fuera de la zona de puntos no la cobra nadie
+	if system.FastestLapPoints > 0 && fastest != nil && scoresPoints(fastest) && *fastest.Position <= system.FastestLapMaxPosition {
+		pointsByResult[fastest.ID] += float64(system.FastestLapPoints)
+	}
+	return pointsByResult, nil
+}
+
+// scoresPoints indica si el resultado está clasificado para sumar puntos
+func scoresPoints(result *model.Result) bool {
+	return result.Status == "FINISHED" && result.Position != nil
+}
+
+// parsePointsTable interpreta una tabla de puntos guardada como "25,18,15,..."
+func parsePointsTable(value string) ([]float64, error) {
+	var table []float64
+	for _, item := range strings.Split(value, ",") {
+		points, err := strconv.ParseFloat(strings.TrimSpace(item), 64)
+		if err != nil {
+			return nil, err
+		}
+		table = append(table, points)
+	}
+	return table, nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"prediapp.local/results/internal/dto"
+	"prediapp.local/results/internal/repository"
+	e "prediapp.local/results/pkg/utils"
+)
+
+// statsSessionNames traduce el tipo de sesión de las estadísticas al session_name guardado
+var statsSessionNames = map[string]string{
+	"race":   "Race",
+	"sprint": "Sprint",
+}
+
+// GetResultStats devuelve victorias, podios, llegada promedio y tasa de abandonos por piloto o por equipo.
+// Los totales se calculan en la base; acá solo se ordenan y se arma la respuesta.
+func (s *resultService) GetResultStats(ctx context.Context, query dto.ResultStatsQueryDTO) (dto.ResultStatsDTO, e.ApiError) {
+	sessionName, ok := statsSessionNames[query.Session]
+	if !ok {
+		return dto.ResultStatsDTO{}, e.NewBadRequestApiError("El tipo de sesión debe ser race o sprint")
+	}
+	if !query.From.Before(query.To) {
+		return dto.ResultStatsDTO{}, e.NewBadRequestApiError("La fecha desde debe ser anterior a la fecha hasta")
+	}
+
+	filter := repository.ResultStatsFilter{
+		From:        query.From,
+		To:          query.To,
+		SessionName: sessionName,
+		DriverID:    query.DriverID,
+		TeamName:    query.TeamName,
+	}
+	var rows []repository.ResultStatsRow
+	var apiErr e.ApiError
+	switch query.GroupBy {
+	case dto.StatsGroupDriver:
+		rows, apiErr = s.resultRepo.GetDriverResultStats(ctx, filter)
+	case dto.StatsGroupTeam:
+		rows, apiErr = s.resultRepo.GetTeamResultStats(ctx, filter)
+	default:
+		return dto.ResultStatsDTO{}, e.NewBadRequestApiError("Las estadísticas se agrupan por driver o por team")
+	}
+	if apiErr != nil {
+		return dto.ResultStatsDTO{}, apiErr
+	}
+
+	// Más victorias, después más podios, después mejor llegada promedio
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Wins != rows[j].Wins {
+			return rows[i].Wins > rows[j].Wins
+		}
+		if rows[i].Podiums != rows[j].Podiums {
+			return rows[i].Podiums > rows[j].Podiums
+		}
+		if (rows[i].AverageFinish == nil) != (rows[j].AverageFinish == nil) {
+			return rows[i].AverageFinish != nil
+		}
+		if rows[i].AverageFinish != nil && *rows[i].AverageFinish != *rows[j].AverageFinish {
+			return *rows[i].AverageFinish < *rows[j].AverageFinish
+		}
+		return rows[i].FullName+rows[i].TeamName < rows[j].FullName+rows[j].TeamName
+	})
+
+	response := dto.ResultStatsDTO{
+		GroupBy: query.GroupBy,
+		Session: query.Session,
+		From:    query.From,
+		To:      query.To,
+		Stats:   make([]dto.ResultStatsEntryDTO, 0, len(rows)),
+	}
+	for _, row := range rows {
+		entry := dto.ResultStatsEntryDTO{
+			DriverID:    row.DriverID,
+			FullName:    row.FullName,
+			NameAcronym: row.NameAcronym,
+			TeamName:    row.TeamName,
+			Entries:     row.Entries,
+			Wins:        row.Wins,
+			Podiums:     row.Podiums,
+			Finishes:    row.Finishes,
+			DNFs:        row.DNFs,
+		}
+		if row.AverageFinish != nil {
+			average := math.Round(*row.AverageFinish*100) / 100
+			entry.AverageFinish = &average
+		}
+		if row.Entries > 0 {
+			entry.DNFRate = math.Round(float64(row.DNFs)/float64(row.Entries)*1000) / 1000
+		}
+		response.Stats = append(response.Stats, entry)
+	}
+	return response, nil
+}
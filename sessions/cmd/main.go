@@ -9,8 +9,8 @@ import (
 	"time"
 
 	"prediapp.local/db"
+	"prediapp.local/racedata"
 	"prediapp.local/sessions/internal/api"
-	"prediapp.local/sessions/internal/client"
 	"prediapp.local/sessions/internal/repository"
 	"prediapp.local/sessions/internal/router"
 	"prediapp.local/sessions/internal/service"
@@ -38,13 +38,16 @@ func main() {
 		log.Fatalf("db.Init failed: %v", err)
 	}
 
-	// 3) Construir cliente y caché
-	externalAPI := client.NewHttpClient("https://api.openf1.org/v1/")
+	// 3) Fuente de datos de carrera (OpenF1 o fixtures en disco según RACE_DATA_PROVIDER) y caché
+	raceData, err := racedata.NewProviderFromEnv()
+	if err != nil {
+		log.Fatalf("race data provider: %v", err)
+	}
 	// cache := utils.NewCache(30*time.Minute, 100)
 
 	// 4) Repositorio, servicio y controlador
 	sRepo := repository.NewSessionRepository(db.DB)
-	sService := service.NewSessionService(sRepo, externalAPI)
+	sService := service.NewSessionService(sRepo, raceData)
 	sController := api.NewSessionController(sService)
 
 	// 5) Router
@@ -7,10 +7,13 @@ require (
 	github.com/json-iterator/go v1.1.12
 	gorm.io/gorm v1.30.0
 	prediapp.local/db v0.0.0
+	prediapp.local/racedata v0.0.0
 )
 
 replace prediapp.local/db => ../db
 
+replace prediapp.local/racedata => ../racedata
+
 require (
 	filippo.io/edwards25519 v1.1.0 // indirect
 	github.com/bytedance/sonic v1.12.6 // indirect
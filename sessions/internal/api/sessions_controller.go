@@ -421,3 +421,165 @@ func (sc *SessionController) UpdateSessionKeyAdmin(c *gin.Context) {
 	// Responder con un estado 200 si la actualización fue exitosa
 	c.Status(http.StatusOK)
 }
+
+// IngestSessionWeather carga (o recarga) el clima de la sesión desde la fuente de datos de carrera
+func (sc *SessionController) IngestSessionWeather(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID inválido"))
+		return
+	}
+
+	response, apiErr := sc.sessionService.IngestSessionWeather(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSessionWeather devuelve el resumen y las mediciones de clima de la sesión
+func (sc *SessionController) GetSessionWeather(c *gin.Context) {
+	sessionID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID inválido"))
+		return
+	}
+
+	response, apiErr := sc.sessionService.GetSessionWeather(c.Request.Context(), sessionID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (sc *SessionController) CreateMeeting(c *gin.Context) {
+	var request dto.CreateMeetingDTO
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Datos inválidos"))
+		return
+	}
+
+	// Validar que las fechas estén en UTC
+	if request.DateStart.Location().String() != "UTC" {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("DateStart debe estar en UTC"))
+		return
+	}
+	if request.DateEnd.Location().String() != "UTC" {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("DateEnd debe estar en UTC"))
+		return
+	}
+
+	response, apiErr := sc.sessionService.CreateMeeting(c.Request.Context(), request)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetMeetingById devuelve el fin de semana con el cronograma completo y el estado de cada sesión
+func (sc *SessionController) GetMeetingById(c *gin.Context) {
+	meetingID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID inválido"))
+		return
+	}
+
+	response, apiErr := sc.sessionService.GetMeetingById(c.Request.Context(), meetingID)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMeetingByKey busca el fin de semana por el meeting_key de OpenF1
+func (sc *SessionController) GetMeetingByKey(c *gin.Context) {
+	meetingKey, err := strconv.Atoi(c.Param("meeting_key"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("meeting_key inválido"))
+		return
+	}
+
+	response, apiErr := sc.sessionService.GetMeetingByKey(c.Request.Context(), meetingKey)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListMeetings lista los fines de semana, opcionalmente filtrados por ?year
+func (sc *SessionController) ListMeetings(c *gin.Context) {
+	year := 0
+	if yearParam := c.Query("year"); yearParam != "" {
+		parsed, err := strconv.Atoi(yearParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Año inválido"))
+			return
+		}
+		year = parsed
+	}
+
+	response, apiErr := sc.sessionService.ListMeetings(c.Request.Context(), year)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (sc *SessionController) UpdateMeeting(c *gin.Context) {
+	meetingID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID inválido"))
+		return
+	}
+
+	var request dto.UpdateMeetingDTO
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("Datos inválidos"))
+		return
+	}
+
+	// Validar que las fechas estén en UTC si se proporcionan
+	if request.DateStart != nil && request.DateStart.Location().String() != "UTC" {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("DateStart debe estar en UTC"))
+		return
+	}
+	if request.DateEnd != nil && request.DateEnd.Location().String() != "UTC" {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("DateEnd debe estar en UTC"))
+		return
+	}
+
+	response, apiErr := sc.sessionService.UpdateMeeting(c.Request.Context(), meetingID, request)
+	if apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (sc *SessionController) DeleteMeeting(c *gin.Context) {
+	meetingID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, e.NewBadRequestApiError("ID inválido"))
+		return
+	}
+
+	if apiErr := sc.sessionService.DeleteMeeting(c.Request.Context(), meetingID); apiErr != nil {
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
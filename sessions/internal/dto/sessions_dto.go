@@ -47,7 +47,7 @@ type UpdateSessionDTO struct {
 
 type ResponseSessionDTO struct {
 	ID               int       `json:"id"`
-	WeekendID        int       `json:"weekend_id"`
+	WeekendID        *int      `json:"weekend_id"`
 	CircuitKey       int       `json:"circuit_key"`
 	CircuitShortName string    `json:"circuit_short_name"`
 	CountryCode      string    `json:"country_code"`
@@ -62,6 +62,8 @@ type ResponseSessionDTO struct {
 	VSC              *bool     `json:"vsc"`
 	SF               *bool     `json:"sf"`
 	DNF              *int      `json:"dnf"`
+
+	Weather *WeatherSummaryDTO `json:"weather,omitempty"` // Solo en el detalle de la sesión, si ya se cargó el clima
 }
 
 type DeleteSessionDTO struct {
@@ -80,18 +82,6 @@ type RaceResultsDTO struct {
 	DFastLap *int  `json:"d_fast_lap,omitempty"`
 }
 
-// RaceControlEvent representa un evento de control de carrera como SC, VSC o banderas
-type RaceControlEvent struct {
-	Category     string `json:"category"`
-	Date         string `json:"date"`
-	DriverNumber *int   `json:"driver_number,omitempty"`
-	Flag         string `json:"flag,omitempty"`
-	LapNumber    *int   `json:"lap_number,omitempty"`
-	MeetingKey   int    `json:"meeting_key"`
-	Message      string `json:"message"`
-	SessionKey   int    `json:"session_key"`
-}
-
 type LapData struct {
 	DriverNumber int      `json:"driver_number"`
 	LapDuration  *float64 `json:"lap_duration"` // Tiempo de vuelta en segundos
@@ -102,15 +92,6 @@ type UpdateDNFDTO struct {
 	DNF int `json:"dnf" binding:"required"`
 }
 
-// SessionKeyResponseDTO representa la respuesta que incluye el session_key, date_start, y date_end
-type SessionKeyResponseDTO struct {
-	SessionKey *int       `json:"session_key"`
-	DateStart  *time.Time `json:"date_start"`
-	DateEnd    *time.Time `json:"date_end"`
-	CountryKey *int       `json:"country_key"`
-	CircuitKey *int       `json:"circuit_key"`
-}
-
 type UpdateSessionKeyDTO struct {
 	Location    string `json:"location" binding:"required"`
 	SessionName string `json:"session_name" binding:"required"`
@@ -147,3 +128,111 @@ type ResponseDriverDTO struct {
 	NameAcronym string `json:"name_acronym"` // Acrónimo del nombre del piloto
 	TeamName    string `json:"team_name"`    // Nombre del equipo del piloto
 }
+
+// WeatherSummaryDTO resume el clima de una sesión a partir de sus mediciones
+type WeatherSummaryDTO struct {
+	Samples             int     `json:"samples"`
+	AirTemperatureMin   float64 `json:"air_temperature_min"` // °C
+	AirTemperatureMax   float64 `json:"air_temperature_max"`
+	AirTemperatureAvg   float64 `json:"air_temperature_avg"`
+	TrackTemperatureMin float64 `json:"track_temperature_min"` // °C
+	TrackTemperatureMax float64 `json:"track_temperature_max"`
+	TrackTemperatureAvg float64 `json:"track_temperature_avg"`
+	HumidityAvg         float64 `json:"humidity_avg"`   // %
+	WindSpeedAvg        float64 `json:"wind_speed_avg"` // m/s
+	WindSpeedMax        float64 `json:"wind_speed_max"`
+	Rainfall            bool    `json:"rainfall"`         // Llovió en al menos una medición
+	RainfallSamples     int     `json:"rainfall_samples"` // Mediciones con lluvia
+}
+
+// WeatherSampleDTO es una medición del clima durante la sesión
+type WeatherSampleDTO struct {
+	Date             time.Time `json:"date"`
+	AirTemperature   float64   `json:"air_temperature"`
+	TrackTemperature float64   `json:"track_temperature"`
+	Humidity         float64   `json:"humidity"`
+	Pressure         float64   `json:"pressure"`
+	Rainfall         bool      `json:"rainfall"`
+	WindDirection    int       `json:"wind_direction"`
+	WindSpeed        float64   `json:"wind_speed"`
+}
+
+// SessionWeatherDTO es el clima de una sesión: el resumen y las mediciones
+type SessionWeatherDTO struct {
+	SessionID int                `json:"session_id"`
+	Summary   *WeatherSummaryDTO `json:"summary"` // nil si no hay mediciones
+	Samples   []WeatherSampleDTO `json:"samples"`
+}
+
+// Estados de una sesión dentro del cronograma del fin de semana
+const (
+	SessionStateUpcoming = "upcoming" // Todavía no empezó
+	SessionStateLive     = "live"     // En curso
+	SessionStateFinished = "finished" // Terminó
+)
+
+// CreateMeetingDTO son los datos para crear un fin de semana de carrera
+type CreateMeetingDTO struct {
+	MeetingKey       *int      `json:"meeting_key"`             // meeting_key de OpenF1, si se conoce
+	Name             string    `json:"name" binding:"required"` // Ej: "Australian Grand Prix"
+	CircuitKey       int       `json:"circuit_key" binding:"required"`
+	CircuitShortName string    `json:"circuit_short_name" binding:"required"`
+	CountryCode      string    `json:"country_code" binding:"required"`
+	CountryKey       int       `json:"country_key"`
+	CountryName      string    `json:"country_name" binding:"required"`
+	Location         string    `json:"location" binding:"required"`
+	DateStart        time.Time `json:"date_start" binding:"required"`
+	DateEnd          time.Time `json:"date_end" binding:"required"`
+	Year             int       `json:"year" binding:"required"`
+	Format           string    `json:"format"` // standard (por defecto) o sprint
+}
+
+// UpdateMeetingDTO son los campos editables de un fin de semana. Los del circuito se replican en sus sesiones.
+type UpdateMeetingDTO struct {
+	MeetingKey       *int       `json:"meeting_key,omitempty"`
+	Name             *string    `json:"name,omitempty"`
+	CircuitKey       *int       `json:"circuit_key,omitempty"`
+	CircuitShortName *string    `json:"circuit_short_name,omitempty"`
+	CountryCode      *string    `json:"country_code,omitempty"`
+	CountryKey       *int       `json:"country_key,omitempty"`
+	CountryName      *string    `json:"country_name,omitempty"`
+	Location         *string    `json:"location,omitempty"`
+	DateStart        *time.Time `json:"date_start,omitempty"`
+	DateEnd          *time.Time `json:"date_end,omitempty"`
+	Year             *int       `json:"year,omitempty"`
+	Format           *string    `json:"format,omitempty"`
+}
+
+// ResponseMeetingDTO representa un fin de semana de carrera
+type ResponseMeetingDTO struct {
+	ID               int       `json:"id"`
+	MeetingKey       *int      `json:"meeting_key"`
+	Name             string    `json:"name"`
+	CircuitKey       int       `json:"circuit_key"`
+	CircuitShortName string    `json:"circuit_short_name"`
+	CountryCode      string    `json:"country_code"`
+	CountryKey       int       `json:"country_key"`
+	CountryName      string    `json:"country_name"`
+	Location         string    `json:"location"`
+	DateStart        time.Time `json:"date_start"`
+	DateEnd          time.Time `json:"date_end"`
+	Year             int       `json:"year"`
+	Format           string    `json:"format"`
+}
+
+// MeetingSessionDTO es una sesión dentro del cronograma del fin de semana
+type MeetingSessionDTO struct {
+	ID          int       `json:"id"`
+	SessionKey  *int      `json:"session_key"`
+	SessionName string    `json:"session_name"`
+	SessionType string    `json:"session_type"`
+	DateStart   time.Time `json:"date_start"`
+	DateEnd     time.Time `json:"date_end"`
+	State       string    `json:"state"` // upcoming, live o finished
+}
+
+// MeetingScheduleDTO es el fin de semana con el cronograma completo de sus sesiones
+type MeetingScheduleDTO struct {
+	ResponseMeetingDTO
+	Sessions []MeetingSessionDTO `json:"sessions"` // Ordenadas por fecha de inicio
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+
+	model "prediapp.local/db/model"
+	e "prediapp.local/sessions/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+// CreateMeeting crea un fin de semana
+func (s *sessionRepository) CreateMeeting(ctx context.Context, meeting *model.Meeting) e.ApiError {
+	meeting.DateStart = meeting.DateStart.UTC() // Forzar UTC
+	meeting.DateEnd = meeting.DateEnd.UTC()     // Forzar UTC
+	if err := s.db.WithContext(ctx).Omit("Sessions").Create(meeting).Error; err != nil {
+		return e.NewInternalServerApiError("Error creando el fin de semana", err)
+	}
+	return nil
+}
+
+func (s *sessionRepository) GetMeetingById(ctx context.Context, meetingID int) (*model.Meeting, e.ApiError) {
+	var meeting model.Meeting
+	if err := s.db.WithContext(ctx).First(&meeting, meetingID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("Fin de semana no encontrado")
+		}
+		return nil, e.NewInternalServerApiError("Error encontrando el fin de semana", err)
+	}
+	meeting.DateStart = meeting.DateStart.UTC()
+	meeting.DateEnd = meeting.DateEnd.UTC()
+	return &meeting, nil
+}
+
+// GetMeetingByKey busca el fin de semana por el meeting_key de OpenF1
+func (s *sessionRepository) GetMeetingByKey(ctx context.Context, meetingKey int) (*model.Meeting, e.ApiError) {
+	var meeting model.Meeting
+	if err := s.db.WithContext(ctx).Where("meeting_key = ?", meetingKey).First(&meeting).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("Fin de semana no encontrado")
+		}
+		return nil, e.NewInternalServerApiError("Error encontrando el fin de semana", err)
+	}
+	meeting.DateStart = meeting.DateStart.UTC()
+	meeting.DateEnd = meeting.DateEnd.UTC()
+	return &meeting, nil
+}
+
+// GetMeetingWithSessions obtiene el fin de semana con sus sesiones ordenadas por fecha de inicio
+func (s *sessionRepository) GetMeetingWithSessions(ctx context.Context, meetingID int) (*model.Meeting, e.ApiError) {
+	var meeting model.Meeting
+	err := s.db.WithContext(ctx).
+		Preload("Sessions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("date_start ASC")
+		}).
+		First(&meeting, meetingID).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("Fin de semana no encontrado")
+		}
+		return nil, e.NewInternalServerApiError("Error encontrando el fin de semana", err)
+	}
+	meeting.DateStart = meeting.DateStart.UTC()
+	meeting.DateEnd = meeting.DateEnd.UTC()
+	for i := range meeting.Sessions {
+		meeting.Sessions[i].DateStart = meeting.Sessions[i].DateStart.UTC()
+		meeting.Sessions[i].DateEnd = meeting.Sessions[i].DateEnd.UTC()
+	}
+	return &meeting, nil
+}
+
+// GetMeetings lista los fines de semana en orden cronológico. Con year 0 devuelve todos.
+func (s *sessionRepository) GetMeetings(ctx context.Context, year int) ([]*model.Meeting, e.ApiError) {
+	var meetings []*model.Meeting
+	query := s.db.WithContext(ctx).Order("date_start ASC")
+	if year != 0 {
+		query = query.Where("year = ?", year)
+	}
+	if err := query.Find(&meetings).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error obteniendo los fines de semana", err)
+	}
+	for _, meeting := range meetings {
+		meeting.DateStart = meeting.DateStart.UTC()
+		meeting.DateEnd = meeting.DateEnd.UTC()
+	}
+	return meetings, nil
+}
+
+// UpdateMeeting actualiza el fin de semana y replica los datos del circuito en sus sesiones, en una sola transacción
+func (s *sessionRepository) UpdateMeeting(ctx context.Context, meeting *model.Meeting) e.ApiError {
+	if meeting.ID == 0 {
+		return e.NewBadRequestApiError("El ID del fin de semana no puede estar vacío")
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Meeting{}).Where("id = ?", meeting.ID).Updates(map[string]interface{}{
+			"meeting_key":        meeting.MeetingKey,
+			"name":               meeting.Name,
+			"circuit_key":        meeting.CircuitKey,
+			"circuit_short_name": meeting.CircuitShortName,
+			"country_code":       meeting.CountryCode,
+			"country_key":        meeting.CountryKey,
+			"country_name":       meeting.CountryName,
+			"location":           meeting.Location,
+			"date_start":         meeting.DateStart.UTC(),
+			"date_end":           meeting.DateEnd.UTC(),
+			"year":               meeting.Year,
+			"format":             meeting.Format,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Model(&model.Session{}).Where("weekend_id = ?", meeting.ID).Updates(map[string]interface{}{
+			"circuit_key":        meeting.CircuitKey,
+			"circuit_short_name": meeting.CircuitShortName,
+			"country_code":       meeting.CountryCode,
+			"country_key":        meeting.CountryKey,
+			"country_name":       meeting.CountryName,
+			"location":           meeting.Location,
+			"year":               meeting.Year,
+		}).Error
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return e.NewNotFoundApiError("No se encontró el fin de semana para actualizar")
+		}
+		return e.NewInternalServerApiError("Error actualizando el fin de semana", err)
+	}
+	return nil
+}
+
+func (s *sessionRepository) DeleteMeeting(ctx context.Context, meetingID int) e.ApiError {
+	// Eliminar físicamente el fin de semana, igual que las sesiones
+	if err := s.db.WithContext(ctx).Unscoped().Where("id = ?", meetingID).Delete(&model.Meeting{}).Error; err != nil {
+		return e.NewInternalServerApiError("Error eliminando el fin de semana", err)
+	}
+	return nil
+}
+
+func (s *sessionRepository) CountMeetingSessions(ctx context.Context, meetingID int) (int64, e.ApiError) {
+	var count int64
+	if err := s.db.WithContext(ctx).Unscoped().Model(&model.Session{}).Where("weekend_id = ?", meetingID).Count(&count).Error; err != nil {
+		return 0, e.NewInternalServerApiError("Error contando las sesiones del fin de semana", err)
+	}
+	return count, nil
+}
@@ -34,6 +34,18 @@ type SessionRepository interface {
 	UpdateSCAndVSC(ctx context.Context, sessionID int, sc bool, vsc bool) e.ApiError
 	UpdateSessionKey(ctx context.Context, session *model.Session) e.ApiError
 	GetSessionsByLocationAndYear(ctx context.Context, location string, year int) ([]*model.Session, e.ApiError)
+	ReplaceSessionWeather(ctx context.Context, sessionID int, samples []*model.WeatherSample) e.ApiError
+	GetSessionWeather(ctx context.Context, sessionID int) ([]*model.WeatherSample, e.ApiError)
+
+	// Fines de semana (meetings)
+	CreateMeeting(ctx context.Context, meeting *model.Meeting) e.ApiError
+	GetMeetingById(ctx context.Context, meetingID int) (*model.Meeting, e.ApiError)
+	GetMeetingByKey(ctx context.Context, meetingKey int) (*model.Meeting, e.ApiError)
+	GetMeetingWithSessions(ctx context.Context, meetingID int) (*model.Meeting, e.ApiError)
+	GetMeetings(ctx context.Context, year int) ([]*model.Meeting, e.ApiError)
+	UpdateMeeting(ctx context.Context, meeting *model.Meeting) e.ApiError
+	DeleteMeeting(ctx context.Context, meetingID int) e.ApiError
+	CountMeetingSessions(ctx context.Context, meetingID int) (int64, e.ApiError)
 }
 
 func NewSessionRepository(db *gorm.DB) SessionRepository {
@@ -278,3 +290,32 @@ func (s *sessionRepository) GetSessionsByLocationAndYear(ctx context.Context, lo
 	}
 	return sessions, nil
 }
+
+// ReplaceSessionWeather reemplaza las mediciones de clima de una sesión por las recibidas, en una sola transacción
+func (s *sessionRepository) ReplaceSessionWeather(ctx context.Context, sessionID int, samples []*model.WeatherSample) e.ApiError {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("session_id = ?", sessionID).Delete(&model.WeatherSample{}).Error; err != nil {
+			return err
+		}
+		if len(samples) == 0 {
+			return nil
+		}
+		for _, sample := range samples {
+			sample.SessionID = sessionID
+		}
+		return tx.Create(&samples).Error
+	})
+	if err != nil {
+		return e.NewInternalServerApiError("Error guardando el clima de la sesión", err)
+	}
+	return nil
+}
+
+// GetSessionWeather obtiene las mediciones de clima de una sesión en orden cronológico
+func (s *sessionRepository) GetSessionWeather(ctx context.Context, sessionID int) ([]*model.WeatherSample, e.ApiError) {
+	var samples []*model.WeatherSample
+	if err := s.db.WithContext(ctx).Where("session_id = ?", sessionID).Order("date").Find(&samples).Error; err != nil {
+		return nil, e.NewInternalServerApiError("Error obteniendo el clima de la sesión", err)
+	}
+	return samples, nil
+}
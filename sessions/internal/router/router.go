@@ -28,6 +28,16 @@ func MapUrls(engine *gin.Engine, sessionController *api.SessionController) {
 	engine.PUT("/sessions/:id/session-data", sessionController.UpdateSessionData)
 	engine.GET("/sessions/:id/get-session-key", sessionController.GetSessionKeyBySessionID)
 	engine.PUT("/sessions/:id/admin-session-key", sessionController.UpdateSessionKeyAdmin)
+	engine.GET("/sessions/:id/weather", sessionController.GetSessionWeather)
+	engine.PUT("/sessions/:id/weather", sessionController.IngestSessionWeather)
+
+	// Rutas de fines de semana (meetings)
+	engine.POST("/meetings", sessionController.CreateMeeting)
+	engine.GET("/meetings", sessionController.ListMeetings)       // ?year=2025
+	engine.GET("/meetings/:id", sessionController.GetMeetingById) // Cronograma completo con el estado de cada sesión
+	engine.GET("/meetings/key/:meeting_key", sessionController.GetMeetingByKey)
+	engine.PUT("/meetings/:id", sessionController.UpdateMeeting)
+	engine.DELETE("/meetings/:id", sessionController.DeleteMeeting)
 
 	// Debugging purpose
 	engine.GET("/ping", func(c *gin.Context) {
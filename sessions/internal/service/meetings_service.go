@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	model "prediapp.local/db/model"
+	dto "prediapp.local/sessions/internal/dto"
+	e "prediapp.local/sessions/pkg/utils"
+)
+
+func (s *sessionService) CreateMeeting(ctx context.Context, request dto.CreateMeetingDTO) (dto.ResponseMeetingDTO, e.ApiError) {
+	format, apiErr := meetingFormat(request.Format)
+	if apiErr != nil {
+		return dto.ResponseMeetingDTO{}, apiErr
+	}
+	if request.DateEnd.Before(request.DateStart) {
+		return dto.ResponseMeetingDTO{}, e.NewBadRequestApiError("La fecha de fin no puede ser anterior a la de inicio")
+	}
+	if apiErr := s.checkMeetingKeyAvailable(ctx, request.MeetingKey, 0); apiErr != nil {
+		return dto.ResponseMeetingDTO{}, apiErr
+	}
+
+	meeting := &model.Meeting{
+		MeetingKey:       request.MeetingKey,
+		Name:             request.Name,
+		CircuitKey:       request.CircuitKey,
+		CircuitShortName: request.CircuitShortName,
+		CountryCode:      request.CountryCode,
+		CountryKey:       request.CountryKey,
+		CountryName:      request.CountryName,
+		Location:         request.Location,
+		DateStart:        request.DateStart.UTC(),
+		DateEnd:          request.DateEnd.UTC(),
+		Year:             request.Year,
+		Format:           format,
+	}
+	if apiErr := s.sessionsRepo.CreateMeeting(ctx, meeting); apiErr != nil {
+		return dto.ResponseMeetingDTO{}, apiErr
+	}
+	return toMeetingDTO(meeting), nil
+}
+
+// GetMeetingById devuelve el fin de semana con el cronograma de sus sesiones y el estado de cada una
+func (s *sessionService) GetMeetingById(ctx context.Context, meetingID int) (dto.MeetingScheduleDTO, e.ApiError) {
+	meeting, apiErr := s.sessionsRepo.GetMeetingWithSessions(ctx, meetingID)
+	if apiErr != nil {
+		return dto.MeetingScheduleDTO{}, apiErr
+	}
+
+	now := time.Now().UTC()
+	schedule := dto.MeetingScheduleDTO{
+		ResponseMeetingDTO: toMeetingDTO(meeting),
+		Sessions:           make([]dto.MeetingSessionDTO, 0, len(meeting.Sessions)),
+	}
+	for _, session := range meeting.Sessions {
+		schedule.Sessions = append(schedule.Sessions, dto.MeetingSessionDTO{
+			ID:          session.ID,
+			SessionKey:  session.SessionKey,
+			SessionName: session.SessionName,
+			SessionType: session.SessionType,
+			DateStart:   session.DateStart,
+			DateEnd:     session.DateEnd,
+			State:       sessionState(session.DateStart, session.DateEnd, now),
+		})
+	}
+	return schedule, nil
+}
+
+// GetMeetingByKey busca el fin de semana por el meeting_key de OpenF1
+func (s *sessionService) GetMeetingByKey(ctx context.Context, meetingKey int) (dto.ResponseMeetingDTO, e.ApiError) {
+	meeting, apiErr := s.sessionsRepo.GetMeetingByKey(ctx, meetingKey)
+	if apiErr != nil {
+		return dto.ResponseMeetingDTO{}, apiErr
+	}
+	return toMeetingDTO(meeting), nil
+}
+
+// ListMeetings lista los fines de semana de un año (o todos con year 0)
+func (s *sessionService) ListMeetings(ctx context.Context, year int) ([]dto.ResponseMeetingDTO, e.ApiError) {
+	meetings, apiErr := s.sessionsRepo.GetMeetings(ctx, year)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	response := make([]dto.ResponseMeetingDTO, 0, len(meetings))
+	for _, meeting := range meetings {
+		response = append(response, toMeetingDTO(meeting))
+	}
+	return response, nil
+}
+
+func (s *sessionService) UpdateMeeting(ctx context.Context, meetingID int, request dto.UpdateMeetingDTO) (dto.ResponseMeetingDTO, e.ApiError) {
+	meeting, apiErr := s.sessionsRepo.GetMeetingById(ctx, meetingID)
+	if apiErr != nil {
+		return dto.ResponseMeetingDTO{}, apiErr
+	}
+
+	// Actualiza solo los campos que están presentes en el DTO de actualización
+	if request.MeetingKey != nil {
+		if apiErr := s.checkMeetingKeyAvailable(ctx, request.MeetingKey, meeting.ID); apiErr != nil {
+			return dto.ResponseMeetingDTO{}, apiErr
+		}
+		meeting.MeetingKey = request.MeetingKey
+	}
+	if request.Name != nil {
+		meeting.Name = *request.Name
+	}
+	if request.CircuitKey != nil {
+		meeting.CircuitKey = *request.CircuitKey
+	}
+	if request.CircuitShortName != nil {
+		meeting.CircuitShortName = *request.CircuitShortName
+	}
+	if request.CountryCode != nil {
+		meeting.CountryCode = *request.CountryCode
+	}
+	if request.CountryKey != nil {
+		meeting.CountryKey = *request.CountryKey
+	}
+	if request.CountryName != nil {
+		meeting.CountryName = *request.CountryName
+	}
+	if request.Location != nil {
+		meeting.Location = *request.Location
+	}
+	if request.DateStart != nil {
+		meeting.DateStart = (*request.DateStart).UTC()
+	}
+	if request.DateEnd != nil {
+		meeting.DateEnd = (*request.DateEnd).UTC()
+	}
+	if request.Year != nil {
+		meeting.Year = *request.Year
+	}
+	if request.Format != nil {
+		format, apiErr := meetingFormat(*request.Format)
+		if apiErr != nil {
+			return dto.ResponseMeetingDTO{}, apiErr
+		}
+		meeting.Format = format
+	}
+	if strings.TrimSpace(meeting.Name) == "" {
+		return dto.ResponseMeetingDTO{}, e.NewBadRequestApiError("El nombre del fin de semana no puede estar vacío")
+	}
+	if meeting.DateEnd.Before(meeting.DateStart) {
+		return dto.ResponseMeetingDTO{}, e.NewBadRequestApiError("La fecha de fin no puede ser anterior a la de inicio")
+	}
+
+	if apiErr := s.sessionsRepo.UpdateMeeting(ctx, meeting); apiErr != nil {
+		return dto.ResponseMeetingDTO{}, apiErr
+	}
+	return toMeetingDTO(meeting), nil
+}
+
+// DeleteMeeting elimina un fin de semana sin sesiones. Las sesiones se eliminan antes, una por una.
+func (s *sessionService) DeleteMeeting(ctx context.Context, meetingID int) e.ApiError {
+	if _, apiErr := s.sessionsRepo.GetMeetingById(ctx, meetingID); apiErr != nil {
+		return apiErr
+	}
+	count, apiErr := s.sessionsRepo.CountMeetingSessions(ctx, meetingID)
+	if apiErr != nil {
+		return apiErr
+	}
+	if count > 0 {
+		return e.NewBadRequestApiError(fmt.Sprintf("El fin de semana tiene %d sesiones cargadas, eliminarlas antes", count))
+	}
+	return s.sessionsRepo.DeleteMeeting(ctx, meetingID)
+}
+
+// ensureMeeting valida que exista el fin de semana de una sesión nueva y extiende sus fechas para cubrirla.
+// Si la sesión es de sprint, el fin de semana pasa a formato sprint.
+func (s *sessionService) ensureMeeting(ctx context.Context, session *model.Session) e.ApiError {
+	if session.WeekendID == nil {
+		return e.NewBadRequestApiError("La sesión debe indicar su fin de semana")
+	}
+	meeting, apiErr := s.sessionsRepo.GetMeetingById(ctx, *session.WeekendID)
+	if apiErr != nil {
+		if apiErr.Status() == http.StatusNotFound {
+			return e.NewBadRequestApiError(fmt.Sprintf("El fin de semana %d no existe", *session.WeekendID))
+		}
+		return apiErr
+	}
+
+	changed := false
+	if session.DateStart.Before(meeting.DateStart) {
+		meeting.DateStart = session.DateStart
+		changed = true
+	}
+	if session.DateEnd.After(meeting.DateEnd) {
+		meeting.DateEnd = session.DateEnd
+		changed = true
+	}
+	if strings.HasPrefix(session.SessionName, "Sprint") && meeting.Format != model.MeetingFormatSprint {
+		meeting.Format = model.MeetingFormatSprint
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return s.sessionsRepo.UpdateMeeting(ctx, meeting)
+}
+
+// checkMeetingKeyAvailable verifica que ningún otro fin de semana use el meeting_key
+func (s *sessionService) checkMeetingKeyAvailable(ctx context.Context, meetingKey *int, meetingID int) e.ApiError {
+	if meetingKey == nil {
+		return nil
+	}
+	existing, apiErr := s.sessionsRepo.GetMeetingByKey(ctx, *meetingKey)
+	if apiErr != nil {
+		if apiErr.Status() == http.StatusNotFound {
+			return nil
+		}
+		return apiErr
+	}
+	if existing.ID != meetingID {
+		return e.NewBadRequestApiError(fmt.Sprintf("Ya existe un fin de semana con el meeting_key %d", *meetingKey))
+	}
+	return nil
+}
+
+// meetingFormat valida el formato del fin de semana; vacío es standard
+func meetingFormat(format string) (string, e.ApiError) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", model.MeetingFormatStandard:
+		return model.MeetingFormatStandard, nil
+	case model.MeetingFormatSprint:
+		return model.MeetingFormatSprint, nil
+	default:
+		return "", e.NewBadRequestApiError("Formato inválido, debe ser standard o sprint")
+	}
+}
+
+// sessionState calcula el estado de la sesión respecto de now
+func sessionState(start, end, now time.Time) string {
+	switch {
+	case now.Before(start):
+		return dto.SessionStateUpcoming
+	case now.After(end):
+		return dto.SessionStateFinished
+	default:
+		return dto.SessionStateLive
+	}
+}
+
+func toMeetingDTO(meeting *model.Meeting) dto.ResponseMeetingDTO {
+	return dto.ResponseMeetingDTO{
+		ID:               meeting.ID,
+		MeetingKey:       meeting.MeetingKey,
+		Name:             meeting.Name,
+		CircuitKey:       meeting.CircuitKey,
+		CircuitShortName: meeting.CircuitShortName,
+		CountryCode:      meeting.CountryCode,
+		CountryKey:       meeting.CountryKey,
+		CountryName:      meeting.CountryName,
+		Location:         meeting.Location,
+		DateStart:        meeting.DateStart.UTC(),
+		DateEnd:          meeting.DateEnd.UTC(),
+		Year:             meeting.Year,
+		Format:           meeting.Format,
+	}
+}
@@ -3,10 +3,11 @@ package service
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	model "prediapp.local/db/model"
-	"prediapp.local/sessions/internal/client"
+	"prediapp.local/racedata"
 	dto "prediapp.local/sessions/internal/dto"
 	repository "prediapp.local/sessions/internal/repository"
 	e "prediapp.local/sessions/pkg/utils"
@@ -14,7 +15,7 @@ import (
 
 type sessionService struct {
 	sessionsRepo repository.SessionRepository
-	client       *client.HttpClient // Agregar el cliente HTTP
+	raceData     racedata.RaceDataProvider // Fuente de datos de carrera (OpenF1 o fixtures)
 	// cache        *e.Cache
 }
 
@@ -38,12 +39,20 @@ type SessionServiceInterface interface {
 	UpdateSessionData(ctx context.Context, sessionID int, location string, sessionName string, sessionType string, year int) e.ApiError
 	GetSessionKeyBySessionID(ctx context.Context, sessionID int) (int, e.ApiError)
 	UpdateSessionKeyAdmin(ctx context.Context, sessionID int, sessionKey int) e.ApiError
+	IngestSessionWeather(ctx context.Context, sessionID int) (dto.SessionWeatherDTO, e.ApiError)
+	GetSessionWeather(ctx context.Context, sessionID int) (dto.SessionWeatherDTO, e.ApiError)
+	CreateMeeting(ctx context.Context, request dto.CreateMeetingDTO) (dto.ResponseMeetingDTO, e.ApiError)
+	GetMeetingById(ctx context.Context, meetingID int) (dto.MeetingScheduleDTO, e.ApiError)
+	GetMeetingByKey(ctx context.Context, meetingKey int) (dto.ResponseMeetingDTO, e.ApiError)
+	ListMeetings(ctx context.Context, year int) ([]dto.ResponseMeetingDTO, e.ApiError)
+	UpdateMeeting(ctx context.Context, meetingID int, request dto.UpdateMeetingDTO) (dto.ResponseMeetingDTO, e.ApiError)
+	DeleteMeeting(ctx context.Context, meetingID int) e.ApiError
 }
 
-func NewSessionService(sessionsRepo repository.SessionRepository, client *client.HttpClient) SessionServiceInterface {
+func NewSessionService(sessionsRepo repository.SessionRepository, raceData racedata.RaceDataProvider) SessionServiceInterface {
 	return &sessionService{
 		sessionsRepo: sessionsRepo,
-		client:       client, // Pasar el cliente HTTP
+		raceData:     raceData,
 		// cache:        cache,
 	}
 }
@@ -96,7 +105,7 @@ func (s *sessionService) CreateSession(ctx context.Context, request dto.CreateSe
 
 	// Convertir el DTO en un modelo para guardarlo en la base de datos
 	newSession := &model.Session{
-		WeekendID:        request.WeekendID,
+		WeekendID:        &request.WeekendID,
 		CircuitKey:       request.CircuitKey,
 		CircuitShortName: request.CircuitShortName,
 		CountryCode:      request.CountryCode,
@@ -104,7 +113,7 @@ func (s *sessionService) CreateSession(ctx context.Context, request dto.CreateSe
 		DateStart:        request.DateStart.UTC(),
 		DateEnd:          request.DateEnd.UTC(),
 		Location:         request.Location,
-		SessionKey:       nil,
+		SessionKey:       request.SessionKey,
 		SessionName:      request.SessionName,
 		SessionType:      request.SessionType,
 		Year:             request.Year,
@@ -115,6 +124,11 @@ func (s *sessionService) CreateSession(ctx context.Context, request dto.CreateSe
 		UpdatedAt:        time.Now().UTC(),
 	}
 
+	// La sesión cuelga de su fin de semana, que tiene que existir
+	if apiErr := s.ensureMeeting(ctx, newSession); apiErr != nil {
+		return dto.ResponseSessionDTO{}, apiErr
+	}
+
 	if err := s.sessionsRepo.CreateSession(ctx, newSession); err != nil {
 		return dto.ResponseSessionDTO{}, e.NewInternalServerApiError("Error creando la sesión", err)
 	}
@@ -168,6 +182,13 @@ func (s *sessionService) GetSessionById(ctx context.Context, sessionID int) (dto
 		DNF:              session.DNF,
 	}
 
+	// Resumen del clima, si ya se cargó
+	samples, err := s.sessionsRepo.GetSessionWeather(ctx, sessionID)
+	if err != nil {
+		return dto.ResponseSessionDTO{}, err
+	}
+	response.Weather = summarizeWeather(samples)
+
 	return response, nil
 }
 
@@ -227,7 +248,13 @@ func (s *sessionService) UpdateSessionById(ctx context.Context, sessionID int, r
 
 	// Actualiza solo los campos que están presentes en el DTO de actualización
 	if request.WeekendID != nil {
-		session.WeekendID = *request.WeekendID
+		if _, apiErr := s.sessionsRepo.GetMeetingById(ctx, *request.WeekendID); apiErr != nil {
+			if apiErr.Status() == http.StatusNotFound {
+				return dto.ResponseSessionDTO{}, e.NewBadRequestApiError("El fin de semana indicado no existe")
+			}
+			return dto.ResponseSessionDTO{}, apiErr
+		}
+		session.WeekendID = request.WeekendID
 	}
 	if request.CircuitKey != nil {
 		session.CircuitKey = *request.CircuitKey
@@ -647,10 +674,12 @@ func (s *sessionService) UpdateResultSCAndVSC(ctx context.Context, sessionID int
 		return apiErr
 	}
 
-	fmt.Printf("Session Key: %v\n", session.SessionKey)
+	if session.SessionKey == nil {
+		return e.NewBadRequestApiError("La sesión no tiene session key asignado")
+	}
 
-	// Usar el SessionKey para hacer la llamada a la API externa
-	raceControlData, err := s.client.GetRaceControlData(session.SessionKey)
+	// Usar el SessionKey para obtener los mensajes de dirección de carrera
+	raceControlData, err := s.raceData.GetRaceControl(*session.SessionKey)
 	if err != nil {
 		return e.NewInternalServerApiError("Error fetching race control data", err)
 	}
@@ -699,30 +728,36 @@ func (s *sessionService) UpdateDNFBySessionID(ctx context.Context, sessionID int
 }
 
 func (s *sessionService) UpdateSessionData(ctx context.Context, sessionID int, location string, sessionName string, sessionType string, year int) e.ApiError {
-	// Obtener el session_data desde la API externa usando el cliente HTTP
-	sessionData, err := s.client.GetSessionData(location, sessionName, sessionType, year)
+	// Obtener el session_data desde la fuente de datos de carrera
+	sessions, err := s.raceData.GetSessions(racedata.SessionFilter{
+		Location:    location,
+		SessionName: sessionName,
+		SessionType: sessionType,
+		Year:        year,
+	})
 	if err != nil {
 		return e.NewInternalServerApiError("Error fetching session data", err)
 	}
+	if len(sessions) == 0 || sessions[0].SessionKey == 0 {
+		return e.NewNotFoundApiError("Session data not found for the given parameters")
+	}
+	sessionData := sessions[0]
 
-	// Si se encontró un session_key, date_start, y date_end, actualizar la sesión en la base de datos
-	if sessionData != nil {
-		// Obtener la sesión actual
-		session, apiErr := s.sessionsRepo.GetSessionById(ctx, sessionID)
-		if apiErr != nil {
-			return apiErr
-		}
+	// Obtener la sesión actual
+	session, apiErr := s.sessionsRepo.GetSessionById(ctx, sessionID)
+	if apiErr != nil {
+		return apiErr
+	}
 
-		// Actualizar los campos session_key, date_start y date_end de la sesión
-		session.SessionKey = sessionData.SessionKey
-		session.DateStart = (*sessionData.DateStart).UTC()
-		session.DateEnd = (*sessionData.DateEnd).UTC()
-		session.CountryKey = *sessionData.CountryKey
-		session.CircuitKey = *sessionData.CircuitKey
+	// Actualizar los campos session_key, date_start y date_end de la sesión
+	session.SessionKey = &sessionData.SessionKey
+	session.DateStart = sessionData.DateStart.UTC()
+	session.DateEnd = sessionData.DateEnd.UTC()
+	session.CountryKey = sessionData.CountryKey
+	session.CircuitKey = sessionData.CircuitKey
 
-		if apiErr := s.sessionsRepo.UpdateSessionById(ctx, session); apiErr != nil {
-			return apiErr
-		}
+	if apiErr := s.sessionsRepo.UpdateSessionById(ctx, session); apiErr != nil {
+		return apiErr
 	}
 
 	return nil
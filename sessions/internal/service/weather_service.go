@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	model "prediapp.local/db/model"
+	dto "prediapp.local/sessions/internal/dto"
+	e "prediapp.local/sessions/pkg/utils"
+)
+
+// IngestSessionWeather trae las mediciones de clima de la sesión desde la fuente de datos de carrera
+// y reemplaza las guardadas. Se puede volver a correr durante la sesión para completar las mediciones.
+func (s *sessionService) IngestSessionWeather(ctx context.Context, sessionID int) (dto.SessionWeatherDTO, e.ApiError) {
+	session, apiErr := s.sessionsRepo.GetSessionById(ctx, sessionID)
+	if apiErr != nil {
+		return dto.SessionWeatherDTO{}, apiErr
+	}
+	if session.SessionKey == nil {
+		return dto.SessionWeatherDTO{}, e.NewBadRequestApiError("La sesión no tiene session key asignado")
+	}
+
+	weather, err := s.raceData.GetWeather(*session.SessionKey)
+	if err != nil {
+		return dto.SessionWeatherDTO{}, e.NewInternalServerApiError("Error fetching weather data", err)
+	}
+
+	// Una medición por instante: si el provider repite la fecha se queda la última
+	byDate := make(map[time.Time]*model.WeatherSample, len(weather))
+	samples := make([]*model.WeatherSample, 0, len(weather))
+	for _, w := range weather {
+		date, err := time.Parse(time.RFC3339Nano, w.Date)
+		if err != nil {
+			continue
+		}
+		sample := &model.WeatherSample{
+			Date:             date.UTC(),
+			AirTemperature:   w.AirTemperature,
+			TrackTemperature: w.TrackTemperature,
+			Humidity:         w.Humidity,
+			Pressure:         w.Pressure,
+			Rainfall:         w.Rainfall > 0,
+			WindDirection:    w.WindDirection,
+			WindSpeed:        w.WindSpeed,
+		}
+		if existing, ok := byDate[sample.Date]; ok {
+			*existing = *sample
+			continue
+		}
+		byDate[sample.Date] = sample
+		samples = append(samples, sample)
+	}
+
+	if apiErr := s.sessionsRepo.ReplaceSessionWeather(ctx, sessionID, samples); apiErr != nil {
+		return dto.SessionWeatherDTO{}, apiErr
+	}
+	return toSessionWeatherDTO(sessionID, samples), nil
+}
+
+// GetSessionWeather devuelve el resumen y las mediciones de clima guardadas de una sesión
+func (s *sessionService) GetSessionWeather(ctx context.Context, sessionID int) (dto.SessionWeatherDTO, e.ApiError) {
+	if _, apiErr := s.sessionsRepo.GetSessionById(ctx, sessionID); apiErr != nil {
+		return dto.SessionWeatherDTO{}, apiErr
+	}
+	samples, apiErr := s.sessionsRepo.GetSessionWeather(ctx, sessionID)
+	if apiErr != nil {
+		return dto.SessionWeatherDTO{}, apiErr
+	}
+	return toSessionWeatherDTO(sessionID, samples), nil
+}
+
+func toSessionWeatherDTO(sessionID int, samples []*model.WeatherSample) dto.SessionWeatherDTO {
+	response := dto.SessionWeatherDTO{
+		SessionID: sessionID,
+		Summary:   summarizeWeather(samples),
+		Samples:   make([]dto.WeatherSampleDTO, 0, len(samples)),
+	}
+	for _, sample := range samples {
+		response.Samples = append(response.Samples, dto.WeatherSampleDTO{
+			Date:             sample.Date.UTC(),
+			AirTemperature:   sample.AirTemperature,
+			TrackTemperature: sample.TrackTemperature,
+			Humidity:         sample.Humidity,
+			Pressure:         sample.Pressure,
+			Rainfall:         sample.Rainfall,
+			WindDirection:    sample.WindDirection,
+			WindSpeed:        sample.WindSpeed,
+		})
+	}
+	return response
+}
+
+// summarizeWeather resume las mediciones de una sesión (nil si no hay ninguna)
+func summarizeWeather(samples []*model.WeatherSample) *dto.WeatherSummaryDTO {
+	if len(samples) == 0 {
+		return nil
+	}
+
+	summary := &dto.WeatherSummaryDTO{
+		Samples:             len(samples),
+		AirTemperatureMin:   samples[0].AirTemperature,
+		AirTemperatureMax:   samples[0].AirTemperature,
+		TrackTemperatureMin: samples[0].TrackTemperature,
+		TrackTemperatureMax: samples[0].TrackTemperature,
+	}
+	var air, track, humidity, wind float64
+	for _, sample := range samples {
+		air += sample.AirTemperature
+		track += sample.TrackTemperature
+		humidity += sample.Humidity
+		wind += sample.WindSpeed
+		summary.AirTemperatureMin = math.Min(summary.AirTemperatureMin, sample.AirTemperature)
+		summary.AirTemperatureMax = math.Max(summary.AirTemperatureMax, sample.AirTemperature)
+		summary.TrackTemperatureMin = math.Min(summary.TrackTemperatureMin, sample.TrackTemperature)
+		summary.TrackTemperatureMax = math.Max(summary.TrackTemperatureMax, sample.TrackTemperature)
+		summary.WindSpeedMax = math.Max(summary.WindSpeedMax, sample.WindSpeed)
+		if sample.Rainfall {
+			summary.Rainfall = true
+			summary.RainfallSamples++
+		}
+	}
+
+	count := float64(len(samples))
+	summary.AirTemperatureAvg = roundWeather(air / count)
+	summary.TrackTemperatureAvg = roundWeather(track / count)
+	summary.HumidityAvg = roundWeather(humidity / count)
+	summary.WindSpeedAvg = roundWeather(wind / count)
+	return summary
+}
+
+// roundWeather redondea a un decimal, la misma precisión con la que informa el provider
+func roundWeather(value float64) float64 {
+	return math.Round(value*10) / 10
+}
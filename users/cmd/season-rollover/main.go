@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"time"
+
+	"prediapp.local/db"
+	"prediapp.local/users/internal/repository"
+	"prediapp.local/users/internal/service"
+)
+
+// Comando para cerrar una temporada: archiva el ranking final en user_season_scores.
+// Uso: go run ./cmd/season-rollover -year 2025
+func main() {
+	year := flag.Int("year", time.Now().Year()-1, "temporada a archivar")
+	flag.Parse()
+
+	for _, envVar := range []string{"DB_HOST", "DB_PORT", "DB_USER", "DB_PASS", "DB_NAME"} {
+		if os.Getenv(envVar) == "" {
+			log.Fatalf("%s is not set in the environment", envVar)
+		}
+	}
+
+	if err := db.Init(); err != nil {
+		log.Fatalf("db.Init failed: %v", err)
+	}
+	defer db.DisconnectDB()
+
+	userService := service.NewUserService(repository.NewUserRepository(db.DB))
+
+	archived, apiErr := userService.ArchiveSeason(context.Background(), *year)
+	if apiErr != nil {
+		log.Fatalf("Season rollover failed: %v", apiErr)
+	}
+	log.Printf("Temporada %d archivada: %d usuarios en el ranking final ✔", *year, archived)
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"prediapp.local/users/internal/dto"
 	"prediapp.local/users/internal/service"
@@ -161,7 +162,14 @@ func (ctrl *UserController) GetUserScoreByUserId(c *gin.Context) {
 		return
 	}
 
-	score, apiErr := ctrl.userService.GetUserScoreByUserId(c.Request.Context(), intID) // Cambiado a int
+	season, err := seasonParam(c)
+	if err != nil {
+		apiErr := e.NewBadRequestApiError("invalid season")
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	score, apiErr := ctrl.userService.GetUserScoreByUserId(c.Request.Context(), intID, season) // Cambiado a int
 	if apiErr != nil {
 		c.JSON(apiErr.Status(), apiErr)
 		return
@@ -172,7 +180,14 @@ func (ctrl *UserController) GetUserScoreByUserId(c *gin.Context) {
 
 func (ctrl *UserController) GetScoreboard(c *gin.Context) {
 	// Implementación de la función para obtener el tablero de puntuaciones
-	scoreboard, apiErr := ctrl.userService.GetScoreboard(c.Request.Context())
+	season, err := seasonParam(c)
+	if err != nil {
+		apiErr := e.NewBadRequestApiError("invalid season")
+		c.JSON(apiErr.Status(), apiErr)
+		return
+	}
+
+	scoreboard, apiErr := ctrl.userService.GetScoreboard(c.Request.Context(), season)
 	if apiErr != nil {
 		c.JSON(apiErr.Status(), apiErr)
 		return
@@ -250,3 +265,12 @@ func (ctrl *UserController) UploadProfilePicture(c *gin.Context) {
 	// 7) Respuesta
 	c.JSON(http.StatusOK, gin.H{"message": "Profile picture uploaded successfully"})
 }
+
+// seasonParam lee el query param `season`; por defecto la temporada en curso
+func seasonParam(c *gin.Context) (int, error) {
+	season := c.Query("season")
+	if season == "" {
+		return time.Now().Year(), nil
+	}
+	return strconv.Atoi(season)
+}
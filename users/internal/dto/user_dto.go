@@ -75,4 +75,6 @@ type UserUpdateRoleRequestDTO struct {
 type UserScoreDtoSimplified struct {
 	Username string `json:"username"`
 	Score    int    `json:"score"`
+	Season   int    `json:"season"`
+	Position *int   `json:"position,omitempty"` // Solo en temporadas archivadas
 }
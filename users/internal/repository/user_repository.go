@@ -25,7 +25,9 @@ type UserRepository interface {
 	UpdateUserByID(ctx context.Context, id int, user *model.User) e.ApiError
 	DeleteUserByID(ctx context.Context, id int) e.ApiError
 	UpdateProfileImage(ctx context.Context, id int, data []byte, mime string) e.ApiError
-	GetScoreboard(ctx context.Context) ([]*model.User, e.ApiError)
+	GetScoreboard(ctx context.Context, season int) ([]*model.UserSeasonScore, e.ApiError)
+	GetUserSeasonScore(ctx context.Context, userID int, season int) (*model.UserSeasonScore, e.ApiError)
+	ArchiveSeason(ctx context.Context, season int) (int, e.ApiError)
 }
 
 // NewUserRepository crea una nueva instancia de userRepository
@@ -131,13 +133,61 @@ func (r *userRepository) UpdateProfileImage(ctx context.Context, id int, data []
 	return nil
 }
 
-func (r *userRepository) GetScoreboard(ctx context.Context) ([]*model.User, e.ApiError) {
-	var users []*model.User
+// GetScoreboard obtiene el ranking de una temporada a partir de user_season_scores
+func (r *userRepository) GetScoreboard(ctx context.Context, season int) ([]*model.UserSeasonScore, e.ApiError) {
+	var scores []*model.UserSeasonScore
 	if err := r.db.WithContext(ctx).
-		Select("username, score").
+		Preload("User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id, username")
+		}).
+		Where("year = ?", season).
 		Order("score desc").
-		Find(&users).Error; err != nil {
-		return nil, e.NewInternalServerApiError("error finding users", err)
+		Order("user_id asc").
+		Find(&scores).Error; err != nil {
+		return nil, e.NewInternalServerApiError("error finding season scores", err)
 	}
-	return users, nil
+	return scores, nil
+}
+
+// GetUserSeasonScore obtiene el puntaje de un usuario en una temporada
+func (r *userRepository) GetUserSeasonScore(ctx context.Context, userID int, season int) (*model.UserSeasonScore, e.ApiError) {
+	var score model.UserSeasonScore
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND year = ?", userID, season).First(&score).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, e.NewNotFoundApiError("season score not found")
+		}
+		return nil, e.NewInternalServerApiError("error finding season score", err)
+	}
+	return &score, nil
+}
+
+// ArchiveSeason congela el ranking final de una temporada guardando la posición de cada usuario.
+// Los empates comparten posición. Devuelve la cantidad de usuarios archivados.
+func (r *userRepository) ArchiveSeason(ctx context.Context, season int) (int, e.ApiError) {
+	archived := 0
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var scores []*model.UserSeasonScore
+		if err := tx.Where("year = ?", season).Order("score desc").Order("user_id asc").Find(&scores).Error; err != nil {
+			return err
+		}
+
+		position := 0
+		for i, score := range scores {
+			if i == 0 || score.Score != scores[i-1].Score {
+				position = i + 1
+			}
+			if err := tx.Model(&model.UserSeasonScore{}).Where("id = ?", score.ID).Updates(map[string]interface{}{
+				"position": position,
+				"archived": true,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		archived = len(scores)
+		return nil
+	})
+	if err != nil {
+		return 0, e.NewInternalServerApiError("error archiving season", err)
+	}
+	return archived, nil
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/base64"
+	"net/http"
 	"time"
 
 	"prediapp.local/db/model"
@@ -25,9 +26,10 @@ type UserServiceInterface interface {
 	UpdateUserById(ctx context.Context, id int, request dto.UserUpdateRequestDTO) (dto.UserResponseDTO, e.ApiError)
 	DeleteUserById(ctx context.Context, id int) e.ApiError
 	UpdateRoleByUserId(ctx context.Context, id int, request dto.UserUpdateRoleRequestDTO) (dto.UserResponseDTO, e.ApiError)
-	GetUserScoreByUserId(ctx context.Context, id int) (dto.UserScoreDtoSimplified, e.ApiError)
+	GetUserScoreByUserId(ctx context.Context, id int, season int) (dto.UserScoreDtoSimplified, e.ApiError)
 	UploadProfilePicture(ctx context.Context, id int, image []byte, mimeType string) e.ApiError
-	GetScoreboard(ctx context.Context) ([]dto.UserScoreDtoSimplified, e.ApiError)
+	GetScoreboard(ctx context.Context, season int) ([]dto.UserScoreDtoSimplified, e.ApiError)
+	ArchiveSeason(ctx context.Context, season int) (int, e.ApiError)
 }
 
 func NewUserService(userRepo repository.UserRepository) UserServiceInterface {
@@ -270,14 +272,24 @@ func (s *userService) UpdateRoleByUserId(ctx context.Context, id int, request dt
 	return response, nil
 }
 
-func (s *userService) GetUserScoreByUserId(ctx context.Context, id int) (dto.UserScoreDtoSimplified, e.ApiError) {
+func (s *userService) GetUserScoreByUserId(ctx context.Context, id int, season int) (dto.UserScoreDtoSimplified, e.ApiError) {
 	user, apiErr := s.userRepo.GetUserByID(ctx, id)
 	if apiErr != nil {
 		return dto.UserScoreDtoSimplified{}, apiErr
 	}
 	response := dto.UserScoreDtoSimplified{
 		Username: user.Username,
-		Score:    user.Score,
+		Season:   season,
+	}
+
+	// Sin registro en la temporada el puntaje es 0
+	seasonScore, apiErr := s.userRepo.GetUserSeasonScore(ctx, id, season)
+	if apiErr != nil && apiErr.Status() != http.StatusNotFound {
+		return dto.UserScoreDtoSimplified{}, apiErr
+	}
+	if seasonScore != nil {
+		response.Score = seasonScore.Score
+		response.Position = seasonScore.Position
 	}
 	return response, nil
 }
@@ -296,19 +308,35 @@ func (s *userService) UploadProfilePicture(ctx context.Context, userID int, data
 	return nil
 }
 
-func (s *userService) GetScoreboard(ctx context.Context) ([]dto.UserScoreDtoSimplified, e.ApiError) {
-	users, apiErr := s.userRepo.GetScoreboard(ctx)
+func (s *userService) GetScoreboard(ctx context.Context, season int) ([]dto.UserScoreDtoSimplified, e.ApiError) {
+	scores, apiErr := s.userRepo.GetScoreboard(ctx, season)
 	if apiErr != nil {
 		return nil, apiErr
 	}
 
-	var scoreboard []dto.UserScoreDtoSimplified
-	for _, user := range users {
-		scoreboard = append(scoreboard, dto.UserScoreDtoSimplified{
-			Username: user.Username,
-			Score:    user.Score,
-		})
+	scoreboard := make([]dto.UserScoreDtoSimplified, 0, len(scores))
+	for _, score := range scores {
+		entry := dto.UserScoreDtoSimplified{
+			Score:    score.Score,
+			Season:   score.Year,
+			Position: score.Position,
+		}
+		if score.User != nil {
+			entry.Username = score.User.Username
+		}
+		scoreboard = append(scoreboard, entry)
 	}
 
 	return scoreboard, nil
 }
+
+// ArchiveSeason cierra una temporada guardando las posiciones finales del ranking
+func (s *userService) ArchiveSeason(ctx context.Context, season int) (int, e.ApiError) {
+	if season <= 0 {
+		return 0, e.NewBadRequestApiError("invalid season")
+	}
+	if season > time.Now().Year() {
+		return 0, e.NewBadRequestApiError("cannot archive a future season")
+	}
+	return s.userRepo.ArchiveSeason(ctx, season)
+}